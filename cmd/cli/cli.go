@@ -10,24 +10,79 @@ import (
 )
 
 func main() {
-	scaleFlag := flag.String("scale", "A minor", "Scale you want to generate")
-	chordFlag := flag.String("chord", "", "Chord you want to highlight (e. g. Amin7)")
-	tuningFlag := flag.String("tuning", "E A D G B E", "Guitar/bass tuning, notes separated by a whitespace")
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "scales":
+			runScalesCommand(os.Args[2:])
+			return
+		case "identify":
+			runIdentifyCommand(os.Args[2:])
+			return
+		case "progression":
+			runProgressionCommand(os.Args[2:])
+			return
+		case "transpose":
+			runTransposeCommand(os.Args[2:])
+			return
+		case "worksheet":
+			runWorksheetCommand(os.Args[2:])
+			return
+		}
+	}
+
+	scaleFlag := flag.String("scale", "A minor", "Scale you want to generate (e. g. A dorian or E phrygian dominant)")
+	formulaFlag := flag.String("formula", "", "Interval formula for a custom scale (e. g. \"1 b2 3 4 5 b6 b7\"), the scale type is used as its name")
+	chordFlag := flag.String("chord", "", "Chord you want to highlight (e. g. Am7, C7b9, F#ø or Am7/G)")
+	tuningFlag := flag.String("tuning", "E A D G B E", "Guitar/bass tuning, notes separated by a whitespace and optionally with octaves (e. g. E1 A1 D2 G2)")
+	namingFlag := flag.String("naming", "english", "Note names of the tuning, english, german (e. g. H and Cis) or dutch (e. g. Cis and Es)")
 	fretsFlag := flag.Uint("frets", 12, "Number of frets on the neck")
-	fileFlag := flag.String("file", "scale.png", "Filename for saving the PNG")
+	fromFlag := flag.Uint("from", 0, "First fret to draw, for a position diagram (e. g. 5)")
+	toFlag := flag.Uint("to", 0, "Last fret to draw, for a position diagram (e. g. 9), defaults to the number of frets")
+	capoFlag := flag.Uint("capo", 0, "Fret the capo is placed on")
+	stringCaposFlag := flag.String("string-capos", "", "Frets of partial capos from the lowest to the highest string, 0 for strings without one (e. g. 002220)")
+	hideOpenStringsFlag := flag.Bool("hide-open-strings", false, "Hide the open strings that are not in the scale or chord")
+	shapesFlag := flag.Bool("shapes", false, "Label the notes with the shapes played relative to the capo instead of the sounding notes")
+	fileFlag := flag.String("file", "scale.png", "Filename for saving the picture, a PNG, an SVG or a PDF depending on the extension")
+	formatFlag := flag.String("format", "", "Output format (png, svg, pdf or text), chosen by the extension of the file if empty. Text is printed to stdout unless a file is given")
+	colorsFlag := flag.Bool("colors", isTerminal(os.Stdout), "Colour the notes of text printed to stdout")
+	tabFlag := flag.Bool("tab", false, "Print a tab of the scale run through the drawn frets to stdout")
+	notesPerStringFlag := flag.Uint("notes-per-string", 0, "Number of notes played on every string of the tab, 0 plays every note of the position")
+	directionFlag := flag.String("direction", "up-down", "Direction of the tab, up, down or up-down")
 	flag.Parse()
 
-	scale, err := buildScale(*scaleFlag)
+	format, err := outputFormat(*formatFlag, *fileFlag)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	direction, err := fretboard.ParseTabDirection(*directionFlag)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	scale, err := buildScale(*scaleFlag, *formulaFlag)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	tuning, err := parseTuning(*tuningFlag, *namingFlag)
 	if err != nil {
 		exitWithError(err)
 	}
 
-	tuning, err := fretboard.NewTuning(*tuningFlag)
+	stringCapos, err := parseStringCapos(*stringCaposFlag)
 	if err != nil {
 		exitWithError(err)
 	}
 
-	fb, err := fretboard.New(fretboard.Options{Tuning: tuning, Frets: *fretsFlag})
+	fb, err := fretboard.New(fretboard.Options{
+		Tuning:      tuning,
+		Frets:       *fretsFlag,
+		StartFret:   *fromFlag,
+		EndFret:     *toFlag,
+		Capo:        *capoFlag,
+		StringCapos: stringCapos,
+	})
 	if err != nil {
 		exitWithError(err)
 	}
@@ -41,30 +96,132 @@ func main() {
 		fb.HighlightChord(chord)
 	}
 
-	f, err := os.Create(*fileFlag)
+	options := renderer.Options{
+		FretboardOffsetX:          40.0,
+		FretboardOffsetY:          50.0,
+		DrawTitle:                 true,
+		HideOutOfScaleOpenStrings: *hideOpenStringsFlag,
+	}
+	if *shapesFlag {
+		options.TextDisplayMode = renderer.TextDisplayModeShapeRelativeToCapo
+	}
+
+	printText := format.Name == renderer.FormatText && !isFlagSet("file")
+	if printText {
+		options.ANSIColors = *colorsFlag
+		err = format.New(fb, options).Render(os.Stdout)
+		if err != nil {
+			exitWithError(err)
+		}
+	} else {
+		err = renderToFile(format.New(fb, options), *fileFlag)
+		if err != nil {
+			exitWithError(err)
+		}
+	}
+
+	if *tabFlag {
+		tab, err := fb.Tab(fretboard.TabOptions{NotesPerString: *notesPerStringFlag, Direction: direction})
+		if err != nil {
+			exitWithError(err)
+		}
+		if printText {
+			fmt.Println()
+		}
+		fmt.Println(tab)
+	}
+}
+
+func renderToFile(r renderer.Renderer, filename string) error {
+	f, err := os.Create(filename)
 	if err != nil {
-		exitWithError(err)
+		return err
 	}
 	defer f.Close()
 
-	options := renderer.PNGOptions{FretboardOffsetX: 40.0, FretboardOffsetY: 50.0, DrawTitle: true}
-	r := renderer.NewPNGRenderer(fb, options)
-	err = r.Render(f)
+	return r.Render(f)
+}
+
+func buildScale(scale string, formula string) (fretboard.Scale, error) {
+	rootNote, scaleType, found := strings.Cut(scale, " ")
+	if !found {
+		return fretboard.Scale{}, fmt.Errorf("scale %q must consist of a root note and a scale type", scale)
+	}
+
+	if formula != "" {
+		return fretboard.NewScaleFromFormula(rootNote, scaleType, formula)
+	}
+
+	return fretboard.NewScale(rootNote, scaleType)
+}
+
+// parseTuning reads the notes of a tuning in the given naming, see fretboard.ParseNoteNaming.
+func parseTuning(tuning string, naming string) (fretboard.Tuning, error) {
+	n, err := fretboard.ParseNoteNaming(naming)
 	if err != nil {
-		_ = f.Close()
-		exitWithError(err)
+		return fretboard.Tuning{}, err
 	}
+
+	return fretboard.ParseTuning(tuning, fretboard.NoteParseOptions{Naming: n})
 }
 
-func buildScale(scale string) (fretboard.Scale, error) {
-	firstWhiteSpace := strings.Index(scale, " ")
-	rootNote := scale[:firstWhiteSpace]
-	scaleType := scale[firstWhiteSpace+1:]
+// newRenderer picks the format from the extension of the filename, see outputFormat.
+func newRenderer(fb *fretboard.Fretboard, options renderer.Options, filename string) renderer.Renderer {
+	format, _ := outputFormat("", filename)
+	return format.New(fb, options)
+}
 
-	return fretboard.NewScale(rootNote, scaleType)
+// outputFormat looks up the format by its name, or by the extension of the filename if no name
+// is given. Unknown extensions fall back to a PNG.
+func outputFormat(name string, filename string) (renderer.Format, error) {
+	if name != "" {
+		format, ok := renderer.DefaultFormats.Lookup(name)
+		if !ok {
+			return renderer.Format{}, fmt.Errorf("format %s is not supported", name)
+		}
+		return format, nil
+	}
+
+	format, ok := renderer.DefaultFormats.LookupFilename(filename)
+	if !ok {
+		format, _ = renderer.DefaultFormats.Lookup(renderer.FormatPNG)
+	}
+	return format, nil
+}
+
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// isTerminal tells whether the file is a terminal rather than a pipe or a regular file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func parseStringCapos(capos string) ([]uint, error) {
+	if capos == "" {
+		return nil, nil
+	}
+	return fretboard.ParseStringCapos(capos)
 }
 
 func exitWithError(e error) {
-	fmt.Println("unable to generate scale:", e)
+	exitWithMessage("unable to generate scale", e)
+}
+
+func exitWithMessage(message string, e error) {
+	fmt.Printf("%s: %s\n", message, e)
 	os.Exit(1)
 }
+
+func exitWithUsage(usage string) {
+	fmt.Println("usage:", usage)
+	os.Exit(2)
+}
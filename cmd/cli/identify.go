@@ -0,0 +1,91 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+func runIdentifyCommand(args []string) {
+	usage := "scalemate-cli identify [-tuning \"E A D G B E\"] [-naming english] -shape x32010\n       scalemate-cli identify [-naming english] C E G"
+
+	flags := flag.NewFlagSet("identify", flag.ExitOnError)
+	shapeFlag := flags.String("shape", "", "Chord shape from the lowest to the highest string, x for muted strings (e. g. x32010 or x-10-12-12-12-x)")
+	tuningFlag := flags.String("tuning", fretboard.TuningStandard, "Guitar/bass tuning the shape is played on")
+	namingFlag := flags.String("naming", "english", "Note names of the tuning and the notes, english, german or dutch")
+	_ = flags.Parse(args)
+
+	naming, err := fretboard.ParseNoteNaming(*namingFlag)
+	if err != nil {
+		exitWithMessage("unable to identify chord", err)
+	}
+
+	var candidates []fretboard.ChordCandidate
+	switch {
+	case *shapeFlag != "" && flags.NArg() == 0:
+		tuning, err := fretboard.ParseTuning(*tuningFlag, fretboard.NoteParseOptions{Naming: naming})
+		if err != nil {
+			exitWithMessage("unable to identify chord", err)
+		}
+
+		fb, err := fretboard.New(fretboard.Options{Tuning: tuning})
+		if err != nil {
+			exitWithMessage("unable to identify chord", err)
+		}
+
+		positions, err := fb.ParseShape(*shapeFlag)
+		if err != nil {
+			exitWithMessage("unable to identify chord", err)
+		}
+
+		candidates, err = fb.IdentifyChord(positions...)
+		if err != nil {
+			exitWithMessage("unable to identify chord", err)
+		}
+	case *shapeFlag == "" && flags.NArg() > 0:
+		notes := make([]fretboard.Note, flags.NArg())
+		for i, arg := range flags.Args() {
+			n, err := fretboard.ParseNote(arg, fretboard.NoteParseOptions{Naming: naming})
+			if err != nil {
+				exitWithMessage("unable to identify chord", err)
+			}
+			notes[i] = n
+		}
+
+		candidates = fretboard.IdentifyChord(notes...)
+	default:
+		exitWithUsage(usage)
+	}
+
+	if len(candidates) == 0 {
+		exitWithMessage("unable to identify chord", errors.New("no chord matches the notes"))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "CHORD\tNOTES\tREMARKS")
+	for _, c := range candidates {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", c.Chord.Name, joinNotes(c.Chord.Notes()), describeCandidate(c))
+	}
+	_ = w.Flush()
+}
+
+func describeCandidate(c fretboard.ChordCandidate) string {
+	var remarks []string
+	if c.Chord.Inversion > 0 {
+		remarks = append(remarks, fmt.Sprintf("inversion %d", c.Chord.Inversion))
+	}
+	if c.Rootless {
+		remarks = append(remarks, "rootless")
+	}
+	for _, i := range c.Missing {
+		if i.Number != 1 {
+			remarks = append(remarks, "no "+i.String())
+		}
+	}
+
+	return strings.Join(remarks, ", ")
+}
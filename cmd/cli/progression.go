@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"github.com/chrismeh/scalemate/pkg/renderer"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+)
+
+func runProgressionCommand(args []string) {
+	usage := "scalemate-cli progression [-key \"C major\"] [-tuning \"E A D G B E\"] [-naming english] [-frets 12] [-capo 0] [-string-capos 002220] [-file progression.png] ii-V-I\n       scalemate-cli progression Dm7 G7 Cmaj7"
+
+	flags := flag.NewFlagSet("progression", flag.ExitOnError)
+	keyFlag := flags.String("key", "", "Key of the progression (e. g. C major), detected from chord names if empty")
+	tuningFlag := flags.String("tuning", fretboard.TuningStandard, "Guitar/bass tuning, notes separated by a whitespace")
+	namingFlag := flags.String("naming", "english", "Note names of the tuning, english, german or dutch")
+	fretsFlag := flags.Uint("frets", 12, "Number of frets on the neck")
+	capoFlag := flags.Uint("capo", 0, "Fret the capo is placed on")
+	stringCaposFlag := flags.String("string-capos", "", "Frets of partial capos from the lowest to the highest string, 0 for strings without one")
+	fileFlag := flags.String("file", "progression.png", "Filename for saving the pictures, numbered for each chord, PNGs, SVGs or PDFs depending on the extension")
+	_ = flags.Parse(args)
+	if flags.NArg() == 0 {
+		exitWithUsage(usage)
+	}
+
+	var key fretboard.Scale
+	if *keyFlag != "" {
+		k, err := buildScale(*keyFlag, "")
+		if err != nil {
+			exitWithMessage("unable to generate progression", err)
+		}
+		key = k
+	}
+
+	progression, err := fretboard.ParseProgression(key, strings.Join(flags.Args(), " "))
+	if err != nil {
+		exitWithMessage("unable to generate progression", err)
+	}
+
+	tuning, err := parseTuning(*tuningFlag, *namingFlag)
+	if err != nil {
+		exitWithMessage("unable to generate progression", err)
+	}
+
+	stringCapos, err := parseStringCapos(*stringCaposFlag)
+	if err != nil {
+		exitWithMessage("unable to generate progression", err)
+	}
+	fretboardOptions := fretboard.Options{Tuning: tuning, Frets: *fretsFlag, Capo: *capoFlag, StringCapos: stringCapos}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "KEY: %s\n", progression.Key.Name())
+	for i, c := range progression.Chords {
+		filename := numberedFilename(*fileFlag, i+1)
+		err := renderProgressionChord(progression.Key, c, fretboardOptions, filename)
+		if err != nil {
+			exitWithMessage("unable to generate progression", err)
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i+1, c.Numeral, c.Name, filename)
+	}
+	_ = w.Flush()
+}
+
+func renderProgressionChord(key fretboard.Scale, c fretboard.ProgressionChord, fretboardOptions fretboard.Options, filename string) error {
+	fb, err := fretboard.New(fretboardOptions)
+	if err != nil {
+		return err
+	}
+	fb.HighlightScale(key)
+	fb.HighlightChord(c.Chord)
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	options := renderer.Options{
+		FretboardOffsetX: 40.0,
+		FretboardOffsetY: 50.0,
+		DrawTitle:        true,
+		Title:            fmt.Sprintf("%s: %s (%s)", c.Numeral, c.Name, key.Name()),
+	}
+	return newRenderer(fb, options, filename).Render(f)
+}
+
+// numberedFilename inserts the number before the extension, e.g. "progression-2.png".
+func numberedFilename(filename string, number int) string {
+	extension := filepath.Ext(filename)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(filename, extension), number, extension)
+}
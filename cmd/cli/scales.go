@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+func runScalesCommand(args []string) {
+	usage := "scalemate-cli scales list\n       scalemate-cli scales find [-limit 10] Am7 D7 Gmaj7"
+	if len(args) == 0 {
+		exitWithUsage(usage)
+	}
+
+	switch args[0] {
+	case "list":
+		if len(args) != 1 {
+			exitWithUsage(usage)
+		}
+		listScales()
+	case "find":
+		findScales(args[1:], usage)
+	default:
+		exitWithUsage(usage)
+	}
+}
+
+func listScales() {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tFAMILY\tFORMULA\tALIASES")
+	for _, d := range fretboard.DefaultScales.Definitions() {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", d.Name, d.Family, d.Formula, strings.Join(d.Aliases, ", "))
+	}
+	_ = w.Flush()
+}
+
+func findScales(args []string, usage string) {
+	flags := flag.NewFlagSet("find", flag.ExitOnError)
+	limitFlag := flags.Int("limit", 10, "Maximum number of scales to show, 0 shows all")
+	_ = flags.Parse(args)
+	if flags.NArg() == 0 {
+		exitWithUsage(usage)
+	}
+
+	query, err := fretboard.ParseScaleQuery(strings.Join(flags.Args(), " "))
+	if err != nil {
+		exitWithMessage("unable to find scales", err)
+	}
+
+	matches := fretboard.FindScales(query)
+	if len(matches) == 0 {
+		exitWithMessage("unable to find scales", fmt.Errorf("no scale contains %s", strings.Join(flags.Args(), " ")))
+	}
+	if *limitFlag > 0 && len(matches) > *limitFlag {
+		matches = matches[:*limitFlag]
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SCALE\tNOTES\tOTHER NOTES")
+	for _, m := range matches {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", m.Scale.Name(), joinNotes(m.Scale.Notes()), joinNotes(m.OtherNotes))
+	}
+	_ = w.Flush()
+}
+
+func joinNotes(notes []fretboard.Note) string {
+	names := make([]string, len(notes))
+	for i, n := range notes {
+		names[i] = n.String()
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var chartSegments = regexp.MustCompile(`\S+|\s+`)
+
+const chartPunctuation = ".,;:!?\"'"
+
+func runTransposeCommand(args []string) {
+	usage := "scalemate-cli transpose -semitones 2 [chart.txt]\n       scalemate-cli transpose [-from Bb] -to G [chart.txt]"
+
+	flags := flag.NewFlagSet("transpose", flag.ExitOnError)
+	semitonesFlag := flags.Int("semitones", 0, "Number of semitones to transpose by, negative numbers transpose down")
+	fromFlag := flags.String("from", "", "Key the chart is written in (e. g. Bb, Am or \"A minor\"), detected from the chords if empty")
+	toFlag := flags.String("to", "", "Key the chart is transposed to (e. g. G or Em)")
+	_ = flags.Parse(args)
+	if flags.NArg() > 1 || (*semitonesFlag == 0 && *toFlag == "") || (*semitonesFlag != 0 && *toFlag != "") {
+		exitWithUsage(usage)
+	}
+
+	chart, err := readChart(flags.Arg(0))
+	if err != nil {
+		exitWithMessage("unable to transpose chart", err)
+	}
+
+	transposed, err := transposeChart(chart, *fromFlag, *toFlag, *semitonesFlag)
+	if err != nil {
+		exitWithMessage("unable to transpose chart", err)
+	}
+	fmt.Print(transposed)
+}
+
+// transposeChart transposes the chord lines of the chart from one key to another, or by a number
+// of semitones if no target key is given. Without a source key the key is detected from the
+// chords. Lines with anything but chord names on them, like lyrics, are left as they are.
+func transposeChart(chart string, fromKey string, toKey string, semitones int) (string, error) {
+	lines := strings.Split(chart, "\n")
+
+	var chords []fretboard.Chord
+	chordLines := make([]bool, len(lines))
+	for i, line := range lines {
+		lineChords, ok := parseChartLine(line)
+		if !ok {
+			continue
+		}
+		chords = append(chords, lineChords...)
+		chordLines[i] = true
+	}
+	if len(chords) == 0 {
+		return "", errors.New("the chart doesn't contain any chords")
+	}
+
+	from := fretboard.DetectKey(chords...)
+	if fromKey != "" {
+		key, err := parseKey(fromKey)
+		if err != nil {
+			return "", err
+		}
+		from = key
+	}
+
+	to := from.Transpose(semitones)
+	if toKey != "" {
+		key, err := parseKey(toKey)
+		if err != nil {
+			return "", err
+		}
+		to = key
+	}
+
+	interval := from.Root.IntervalTo(to.Root)
+	for i, line := range lines {
+		if chordLines[i] {
+			lines[i] = transposeChartLine(line, interval)
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// parseKey accepts a key as a root note, optionally followed by "m" for minor keys, e.g. "Bb" or
+// "F#m", or as a scale like "A minor". Only the root of the key decides the interval a chart is
+// transposed by, so the mode of the chart stays the same.
+func parseKey(key string) (fretboard.Scale, error) {
+	key = strings.TrimSpace(key)
+	if strings.Contains(key, " ") {
+		return buildScale(key, "")
+	}
+	if root, minor := strings.CutSuffix(key, "m"); minor {
+		return fretboard.NewScale(root, fretboard.ScaleMinor)
+	}
+	return fretboard.NewScale(key, fretboard.ScaleMajor)
+}
+
+// parseChartLine returns the chords of a line if every word on it is a chord name. Roots and bass
+// notes have to be uppercase letters and punctuation isn't allowed, so that lyrics like "A day to
+// remember" or "Go, go!" aren't mistaken for chords.
+func parseChartLine(line string) ([]fretboard.Chord, bool) {
+	tokens := strings.Fields(line)
+	if len(tokens) == 0 {
+		return nil, false
+	}
+
+	chords := make([]fretboard.Chord, 0, len(tokens))
+	for _, token := range tokens {
+		c, ok := parseChartChord(token)
+		if !ok {
+			return nil, false
+		}
+		chords = append(chords, c)
+	}
+	return chords, true
+}
+
+func parseChartChord(token string) (fretboard.Chord, bool) {
+	if !isChartNoteLetter(token[0]) || strings.ContainsAny(token, chartPunctuation) {
+		return fretboard.Chord{}, false
+	}
+	if i := strings.LastIndex(token, "/"); i >= 0 && i+1 < len(token) {
+		if r := token[i+1]; r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			if !isChartNoteLetter(r) {
+				return fretboard.Chord{}, false
+			}
+		}
+	}
+
+	c, err := fretboard.ParseChord(token)
+	if err != nil {
+		return fretboard.Chord{}, false
+	}
+	return c, true
+}
+
+func isChartNoteLetter(b byte) bool {
+	return b >= 'A' && b <= 'G'
+}
+
+func readChart(filename string) (string, error) {
+	if filename == "" {
+		chart, err := io.ReadAll(os.Stdin)
+		return string(chart), err
+	}
+
+	chart, err := os.ReadFile(filename)
+	return string(chart), err
+}
+
+// transposeChartLine transposes every chord name of the line and leaves all other words as they
+// are. The whitespace after a chord grows or shrinks with its name, so that chords written above
+// lyrics stay in place.
+func transposeChartLine(line string, interval fretboard.Interval) string {
+	var b strings.Builder
+	shift := 0
+	for _, segment := range chartSegments.FindAllString(line, -1) {
+		if strings.TrimSpace(segment) != "" {
+			transposed := transposeChordSymbol(segment, interval)
+			shift += utf8.RuneCountInString(transposed) - utf8.RuneCountInString(segment)
+			b.WriteString(transposed)
+			continue
+		}
+
+		switch {
+		case shift < 0:
+			segment += strings.Repeat(" ", -shift)
+			shift = 0
+		case shift > 0 && len(segment) > 1:
+			removed := min(shift, len(segment)-1)
+			segment = segment[removed:]
+			shift -= removed
+		}
+		b.WriteString(segment)
+	}
+	return b.String()
+}
+
+// transposeChordSymbol replaces the root and the bass note of a chord name and keeps the way
+// the chord type was written, so "Bbm7/F" becomes "Gm7/D" and not "Gmin7/D".
+func transposeChordSymbol(symbol string, interval fretboard.Interval) string {
+	c, err := fretboard.ParseChord(symbol)
+	if err != nil {
+		return symbol
+	}
+	t := c.TransposeInterval(interval)
+
+	name := symbol
+	if !c.Bass.IsZero() {
+		name = symbol[:strings.LastIndex(symbol, "/")]
+	}
+
+	root := len(name)
+	for ; root > 0; root-- {
+		if n, err := fretboard.NewNote(name[:root]); err == nil && n.Equals(c.Root) {
+			break
+		}
+	}
+
+	transposed := t.Root.String() + name[root:]
+	if !t.Bass.IsZero() {
+		transposed += "/" + t.Bass.String()
+	}
+	return transposed
+}
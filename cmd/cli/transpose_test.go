@@ -0,0 +1,67 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTransposeChart(t *testing.T) {
+	t.Run("transpose only the lines that consist of chords", func(t *testing.T) {
+		chart := "G       D      Em   C\n" +
+			"A day to remember\n" +
+			"C        G/B\n" +
+			"I am so happy, go and do it\n"
+
+		transposed, err := transposeChart(chart, "", "", 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "A       E      F#m  D\n"+
+			"A day to remember\n"+
+			"D        A/C#\n"+
+			"I am so happy, go and do it\n", transposed)
+	})
+
+	t.Run("detect the key from the chord lines only", func(t *testing.T) {
+		chart := "Am  Dm  E7  Am\n" +
+			"A Bb, C E F!\n" +
+			"a d e a\n"
+
+		transposed, err := transposeChart(chart, "", "Em", 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Em  Am  B7  Em\n"+
+			"A Bb, C E F!\n"+
+			"a d e a\n", transposed)
+	})
+
+	t.Run("accept keys with their mode", func(t *testing.T) {
+		tests := []struct {
+			From     string
+			To       string
+			Expected string
+		}{
+			{From: "Am", To: "Em", Expected: "Em  B7"},
+			{From: "A minor", To: "C#m", Expected: "C#m G#7"},
+			{From: "Am", To: "D dorian", Expected: "Dm  A7"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.From+" to "+tt.To, func(t *testing.T) {
+				transposed, err := transposeChart("Am  E7", tt.From, tt.To, 0)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, transposed)
+			})
+		}
+	})
+
+	t.Run("return error for an unknown key", func(t *testing.T) {
+		_, err := transposeChart("Am  E7", "H", "G", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("return error if the chart doesn't contain any chords", func(t *testing.T) {
+		_, err := transposeChart("A day to remember\n", "", "G", 0)
+		assert.Error(t, err)
+	})
+}
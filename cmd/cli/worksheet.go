@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"github.com/chrismeh/scalemate/pkg/renderer"
+	"os"
+)
+
+func runWorksheetCommand(args []string) {
+	usage := "scalemate-cli worksheet -scale \"A minor\" [-tuning \"E A D G B E\"] [-naming english] [-frets 12] [-capo 0] [-chords=true] [-page A4] [-notes 3] [-title \"\"] [-file worksheet.pdf]"
+
+	flags := flag.NewFlagSet("worksheet", flag.ExitOnError)
+	scaleFlag := flags.String("scale", "", "Scale of the worksheet (e. g. A minor)")
+	tuningFlag := flags.String("tuning", fretboard.TuningStandard, "Guitar/bass tuning, notes separated by a whitespace")
+	namingFlag := flags.String("naming", "english", "Note names of the tuning, english, german or dutch")
+	fretsFlag := flags.Uint("frets", 12, "Number of frets on the neck")
+	capoFlag := flags.Uint("capo", 0, "Fret the capo is placed on")
+	chordsFlag := flags.Bool("chords", true, "Add a diagram for every diatonic chord of the scale")
+	pageFlag := flags.String("page", renderer.PageA4.Name, "Page size, A4 or Letter")
+	notesFlag := flags.Int("notes", 3, "Number of lines for notes below every diagram")
+	titleFlag := flags.String("title", "", "Title on every page, the name of the scale if empty")
+	fileFlag := flags.String("file", "worksheet.pdf", "Filename for saving the worksheet")
+	_ = flags.Parse(args)
+	if *scaleFlag == "" || flags.NArg() != 0 {
+		exitWithUsage(usage)
+	}
+
+	scale, err := buildScale(*scaleFlag, "")
+	if err != nil {
+		exitWithMessage("unable to generate worksheet", err)
+	}
+
+	tuning, err := parseTuning(*tuningFlag, *namingFlag)
+	if err != nil {
+		exitWithMessage("unable to generate worksheet", err)
+	}
+
+	pageSize, err := renderer.ParsePageSize(*pageFlag)
+	if err != nil {
+		exitWithMessage("unable to generate worksheet", err)
+	}
+
+	title := *titleFlag
+	if title == "" {
+		title = scale.Name()
+	}
+	worksheet := renderer.NewWorksheet(title, pageSize)
+	worksheet.NoteLines = *notesFlag
+
+	fretboardOptions := fretboard.Options{Tuning: tuning, Frets: *fretsFlag, Capo: *capoFlag}
+	fb, err := fretboard.New(fretboardOptions)
+	if err != nil {
+		exitWithMessage("unable to generate worksheet", err)
+	}
+	fb.HighlightScale(scale)
+	worksheet.AddFretboard(fb, renderer.Options{})
+
+	if *chordsFlag {
+		for _, c := range scale.Chords() {
+			fb, err := fretboard.New(fretboardOptions)
+			if err != nil {
+				exitWithMessage("unable to generate worksheet", err)
+			}
+			fb.HighlightScale(scale)
+			fb.HighlightChord(c.Chord)
+			worksheet.AddFretboard(fb, renderer.Options{Title: fmt.Sprintf("%s: %s", c.Numeral, c.Name)})
+		}
+	}
+
+	f, err := os.Create(*fileFlag)
+	if err != nil {
+		exitWithMessage("unable to generate worksheet", err)
+	}
+	defer f.Close()
+
+	err = worksheet.Render(f)
+	if err != nil {
+		_ = f.Close()
+		exitWithMessage("unable to generate worksheet", err)
+	}
+}
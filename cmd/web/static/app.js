@@ -1,21 +1,51 @@
+function loadScales() {
+    fetch("/api/scales")
+        .then(resp => resp.json())
+        .then(json => {
+            let scaleSelect = document.getElementById("scale");
+            let groups = {};
+
+            for (let scale of json) {
+                if (!(scale.family in groups)) {
+                    groups[scale.family] = document.createElement("optgroup");
+                    groups[scale.family].label = scale.family;
+                    scaleSelect.appendChild(groups[scale.family]);
+                }
+
+                let opt = document.createElement("option");
+                opt.value = scale.name;
+                opt.innerHTML = scale.name;
+                opt.title = scale.formula;
+                opt.selected = scale.name === "minor";
+                groups[scale.family].appendChild(opt);
+            }
+
+            sendScaleRequest(true);
+        })
+}
+
 function sendScaleRequest(updateChordSelector) {
     const root = encodeURIComponent(document.getElementById("root").value);
     const scale = encodeURIComponent(document.getElementById("scale").value);
     const tuning = encodeURIComponent(document.getElementById("tuning").value);
     const frets = encodeURIComponent(document.getElementById("frets").value);
+    const capo = capoParameter(document.getElementById("capo").value);
     const displayMode = encodeURIComponent(document.getElementById("display-mode").value);
+    const chordSize = encodeURIComponent(document.getElementById("chord-size").value);
+    const hideOpenStrings = document.getElementById("hide-open-strings").checked;
 
-    let url = `/api/scale?root=${root}&type=${scale}&tuning=${tuning}&frets=${frets}&displayMode=${displayMode}`
+    let url = `/api/scale?root=${root}&type=${scale}&tuning=${tuning}&frets=${frets}&${capo}&displayMode=${displayMode}&chordSize=${chordSize}&hideOpenStrings=${hideOpenStrings}`
 
     let chord = document.getElementById("chord").value
-    if (chord !== "-") {
+    if (chord !== "-" && !updateChordSelector) {
         url += "&chord=" + encodeURIComponent(chord);
     }
 
     fetch(url)
         .then(resp => resp.json())
         .then(json => {
-            document.getElementById("scale-image").src = `data:image/png;base64,${json.picture}`;
+            document.getElementById("scale-image").src = `data:${json.mimeType};base64,${json.picture}`;
+            document.getElementById("scale-tab").innerText = json.tab;
 
             if (!updateChordSelector) {
                 return;
@@ -32,9 +62,57 @@ function sendScaleRequest(updateChordSelector) {
 
             for (let chord of json.chords) {
                 let opt = document.createElement("option")
-                opt.value = chord;
-                opt.innerHTML = chord;
+                opt.value = chord.name;
+                opt.innerHTML = `${chord.numeral}: ${chord.name}`;
                 chordSelect.appendChild(opt)
             }
         })
-}
\ No newline at end of file
+}
+
+function sendProgressionRequest() {
+    const root = encodeURIComponent(document.getElementById("root").value);
+    const scale = encodeURIComponent(document.getElementById("scale").value);
+    const tuning = encodeURIComponent(document.getElementById("tuning").value);
+    const frets = encodeURIComponent(document.getElementById("frets").value);
+    const capo = capoParameter(document.getElementById("capo").value);
+    const displayMode = encodeURIComponent(document.getElementById("display-mode").value);
+    const progression = encodeURIComponent(document.getElementById("progression").value);
+
+    let url = `/api/progression?root=${root}&type=${scale}&tuning=${tuning}&frets=${frets}&${capo}&displayMode=${displayMode}&progression=${progression}`
+
+    let errorText = document.getElementById("progression-error");
+    let container = document.getElementById("progression-chords");
+    errorText.innerHTML = "";
+    container.innerHTML = "";
+
+    fetch(url)
+        .then(resp => {
+            if (!resp.ok) {
+                return resp.text().then(text => Promise.reject(text));
+            }
+            return resp.json();
+        })
+        .then(json => {
+            for (let chord of json.chords) {
+                let figure = document.createElement("figure");
+                let img = document.createElement("img");
+                img.src = `data:${json.mimeType};base64,${chord.picture}`;
+                img.alt = chord.name;
+
+                let caption = document.createElement("figcaption");
+                caption.innerText = `${chord.numeral}: ${chord.name} (${json.key})`;
+
+                figure.appendChild(img);
+                figure.appendChild(caption);
+                container.appendChild(figure);
+            }
+        })
+        .catch(err => errorText.innerText = err);
+}
+
+function capoParameter(capo) {
+    if (capo.includes(" ")) {
+        return `stringCapos=${encodeURIComponent(capo)}`;
+    }
+    return `capo=${encodeURIComponent(capo)}`;
+}
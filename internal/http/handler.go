@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"github.com/chrismeh/scalemate/pkg/fretboard"
 	"github.com/chrismeh/scalemate/pkg/renderer"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 )
 
 func (a Application) handleGetIndex(w http.ResponseWriter, r *http.Request) {
@@ -41,33 +44,193 @@ func (a Application) handleGetScale(w http.ResponseWriter, r *http.Request) {
 
 	request := parseGetScaleRequest(r)
 	fb, err := buildFretboard(request)
+	if err != nil {
+		a.badRequest(err, w)
+		return
+	}
 
-	chords := make([]string, 0, 8)
-	for _, c := range fb.Scale.Chords() {
-		chords = append(chords, c.Name)
+	type diatonicChord struct {
+		Name    string `json:"name"`
+		Numeral string `json:"numeral"`
+		Degree  int    `json:"degree"`
 	}
 
-	options := renderer.PNGOptions{
-		FretboardOffsetX: 0,
-		FretboardOffsetY: 40.0,
-		DrawTitle:        false,
-		TextDisplayMode:  request.displayMode,
+	scaleChords, err := fb.Scale.ChordsOfSize(request.chordSize)
+	if err != nil {
+		a.badRequest(err, w)
+		return
 	}
-	png := renderer.NewPNGRenderer(fb, options)
 
-	var buf bytes.Buffer
-	err = png.Render(&buf)
+	chords := make([]diatonicChord, 0, 8)
+	for _, c := range scaleChords {
+		chords = append(chords, diatonicChord{Name: c.Name, Numeral: c.Numeral, Degree: c.Degree})
+	}
+
+	picture, err := renderPicture(fb, request)
 	if err != nil {
 		a.internalServerError(err, w)
 		return
 	}
 
+	// Frets without any highlighted note have no tab, the picture is still returned.
+	var tab string
+	if t, err := fb.Tab(request.tab); err == nil {
+		tab = t.String()
+	}
+
+	resp := struct {
+		Picture  string          `json:"picture"`
+		MimeType string          `json:"mimeType"`
+		Tab      string          `json:"tab"`
+		Chords   []diatonicChord `json:"chords"`
+	}{
+		Picture:  picture,
+		MimeType: request.format.MimeType,
+		Tab:      tab,
+		Chords:   chords,
+	}
+
+	w.Header().Add("content-type", "application/json")
+	err = json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		a.internalServerError(err, w)
+		return
+	}
+}
+
+func (a Application) handleGetProgression(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	request := parseGetScaleRequest(r)
+	fretboardOptions, err := buildFretboardOptions(request)
+	if err != nil {
+		a.badRequest(err, w)
+		return
+	}
+
+	var key fretboard.Scale
+	if r.URL.Query().Get("root") != "" {
+		key, err = buildScale(request)
+		if err != nil {
+			a.badRequest(err, w)
+			return
+		}
+	}
+
+	progression, err := fretboard.ParseProgression(key, r.URL.Query().Get("progression"))
+	if err != nil {
+		a.badRequest(err, w)
+		return
+	}
+
+	type progressionChord struct {
+		Name      string `json:"name"`
+		Numeral   string `json:"numeral"`
+		Secondary bool   `json:"secondary"`
+		Borrowed  bool   `json:"borrowed"`
+		Picture   string `json:"picture"`
+	}
+
+	chords := make([]progressionChord, len(progression.Chords))
+	for i, c := range progression.Chords {
+		fb, err := fretboard.New(fretboardOptions)
+		if err != nil {
+			a.badRequest(err, w)
+			return
+		}
+		fb.HighlightScale(progression.Key)
+		fb.HighlightChord(c.Chord)
+
+		picture, err := renderPicture(fb, request)
+		if err != nil {
+			a.internalServerError(err, w)
+			return
+		}
+		chords[i] = progressionChord{Name: c.Name, Numeral: c.Numeral, Secondary: c.Secondary, Borrowed: c.Borrowed, Picture: picture}
+	}
+
 	resp := struct {
-		Picture string   `json:"picture"`
-		Chords  []string `json:"chords"`
+		Key      string             `json:"key"`
+		MimeType string             `json:"mimeType"`
+		Chords   []progressionChord `json:"chords"`
 	}{
-		Picture: base64.StdEncoding.EncodeToString(buf.Bytes()),
-		Chords:  chords,
+		Key:      progression.Key.Name(),
+		MimeType: request.format.MimeType,
+		Chords:   chords,
+	}
+
+	w.Header().Add("content-type", "application/json")
+	err = json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		a.internalServerError(err, w)
+		return
+	}
+}
+
+func (a Application) handleGetScales(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	type scaleDefinition struct {
+		Name    string   `json:"name"`
+		Aliases []string `json:"aliases"`
+		Formula string   `json:"formula"`
+		Family  string   `json:"family"`
+	}
+
+	definitions := fretboard.DefaultScales.Definitions()
+	resp := make([]scaleDefinition, len(definitions))
+	for i, d := range definitions {
+		resp[i] = scaleDefinition{Name: d.Name, Aliases: d.Aliases, Formula: d.Formula, Family: d.Family}
+	}
+
+	w.Header().Add("content-type", "application/json")
+	err := json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		a.internalServerError(err, w)
+		return
+	}
+}
+
+func (a Application) handleGetFindScales(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query, err := fretboard.ParseScaleQuery(r.URL.Query().Get("query"))
+	if err != nil {
+		a.badRequest(err, w)
+		return
+	}
+
+	matches := fretboard.FindScales(query)
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	type scaleMatch struct {
+		Name       string   `json:"name"`
+		Root       string   `json:"root"`
+		Notes      []string `json:"notes"`
+		OtherNotes []string `json:"otherNotes"`
+		Tonic      bool     `json:"tonic"`
+	}
+
+	resp := make([]scaleMatch, len(matches))
+	for i, m := range matches {
+		resp[i] = scaleMatch{
+			Name:       m.Scale.Name(),
+			Root:       m.Scale.Root.String(),
+			Notes:      noteNames(m.Scale.Notes()),
+			OtherNotes: noteNames(m.OtherNotes),
+			Tonic:      m.Tonic,
+		}
 	}
 
 	w.Header().Add("content-type", "application/json")
@@ -78,13 +241,126 @@ func (a Application) handleGetScale(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (a Application) handleGetIdentify(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	candidates, err := identifyChord(r.URL.Query())
+	if err != nil {
+		a.badRequest(err, w)
+		return
+	}
+
+	type chordCandidate struct {
+		Name      string   `json:"name"`
+		Notes     []string `json:"notes"`
+		Inversion int      `json:"inversion"`
+		Rootless  bool     `json:"rootless"`
+		Missing   []string `json:"missing"`
+	}
+
+	resp := make([]chordCandidate, len(candidates))
+	for i, c := range candidates {
+		missing := make([]string, len(c.Missing))
+		for j, interval := range c.Missing {
+			missing[j] = interval.String()
+		}
+
+		resp[i] = chordCandidate{
+			Name:      c.Chord.Name,
+			Notes:     noteNames(c.Chord.Notes()),
+			Inversion: c.Chord.Inversion,
+			Rootless:  c.Rootless,
+			Missing:   missing,
+		}
+	}
+
+	w.Header().Add("content-type", "application/json")
+	err = json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		a.internalServerError(err, w)
+		return
+	}
+}
+
+// identifyChord reads either a shape like "x32010" played in the given tuning or a list of notes
+// like "C E G" from the query, the lowest note coming first. The notes of both are written in the
+// naming given in the query, English by default.
+func identifyChord(query url.Values) ([]fretboard.ChordCandidate, error) {
+	naming, err := fretboard.ParseNoteNaming(query.Get("naming"))
+	if err != nil {
+		return nil, err
+	}
+	parseOptions := fretboard.NoteParseOptions{Naming: naming}
+
+	if shape := query.Get("shape"); shape != "" {
+		tuning := fretboard.TuningStandard
+		if t := query.Get("tuning"); t != "" {
+			tuning = t
+		}
+
+		t, err := fretboard.ParseTuning(tuning, parseOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		fb, err := fretboard.New(fretboard.Options{Tuning: t})
+		if err != nil {
+			return nil, err
+		}
+
+		positions, err := fb.ParseShape(shape)
+		if err != nil {
+			return nil, err
+		}
+
+		return fb.IdentifyChord(positions...)
+	}
+
+	fields := strings.FieldsFunc(query.Get("notes"), func(r rune) bool { return r == ' ' || r == ',' })
+	if len(fields) == 0 {
+		return nil, errors.New("either notes or a shape must be given")
+	}
+
+	notes := make([]fretboard.Note, len(fields))
+	for i, f := range fields {
+		n, err := fretboard.ParseNote(f, parseOptions)
+		if err != nil {
+			return nil, err
+		}
+		notes[i] = n
+	}
+
+	return fretboard.IdentifyChord(notes...), nil
+}
+
+func noteNames(notes []fretboard.Note) []string {
+	names := make([]string, len(notes))
+	for i, n := range notes {
+		names[i] = n.String()
+	}
+	return names
+}
+
 type getScaleRequest struct {
 	rootNote    string
 	scaleType   string
+	formula     string
 	tuning      string
+	naming      fretboard.NoteNaming
 	frets       uint
+	startFret   uint
+	endFret     uint
+	capo        uint
+	stringCapos string
 	chord       string
+	chordSize   fretboard.ChordSize
 	displayMode renderer.TextDisplayMode
+	hideOpen    bool
+	format      renderer.Format
+	tab         fretboard.TabOptions
 }
 
 func parseGetScaleRequest(r *http.Request) getScaleRequest {
@@ -94,8 +370,10 @@ func parseGetScaleRequest(r *http.Request) getScaleRequest {
 		tuning:      fretboard.TuningStandard,
 		frets:       12,
 		chord:       "",
+		chordSize:   fretboard.ChordSizeSeventh,
 		displayMode: renderer.TextDisplayModeDefault,
 	}
+	req.format, _ = renderer.DefaultFormats.Lookup(renderer.FormatPNG)
 
 	query := r.URL.Query()
 	if rootNote := query.Get("root"); rootNote != "" {
@@ -104,18 +382,69 @@ func parseGetScaleRequest(r *http.Request) getScaleRequest {
 	if scaleType := query.Get("type"); scaleType != "" {
 		req.scaleType = scaleType
 	}
+	if formula := query.Get("formula"); formula != "" {
+		req.formula = formula
+		if query.Get("type") == "" {
+			req.scaleType = "custom"
+		}
+	}
 	if tuning := query.Get("tuning"); tuning != "" {
 		req.tuning = tuning
 	}
+	if naming, err := fretboard.ParseNoteNaming(query.Get("naming")); err == nil {
+		req.naming = naming
+	}
 	if frets := query.Get("frets"); frets != "" {
 		numberOfFrets, err := strconv.Atoi(frets)
 		if err == nil && numberOfFrets > 0 {
 			req.frets = uint(numberOfFrets)
 		}
 	}
+	if from := query.Get("from"); from != "" {
+		startFret, err := strconv.Atoi(from)
+		if err == nil && startFret > 0 {
+			req.startFret = uint(startFret)
+		}
+	}
+	if to := query.Get("to"); to != "" {
+		endFret, err := strconv.Atoi(to)
+		if err == nil && endFret > 0 {
+			req.endFret = uint(endFret)
+		}
+	}
+	if capo := query.Get("capo"); capo != "" {
+		capoFret, err := strconv.Atoi(capo)
+		if err == nil && capoFret > 0 {
+			req.capo = uint(capoFret)
+		}
+	}
+	if stringCapos := query.Get("stringCapos"); stringCapos != "" {
+		req.stringCapos = stringCapos
+	}
 	if chord := query.Get("chord"); chord != "" {
 		req.chord = chord
 	}
+	if size := query.Get("chordSize"); size != "" {
+		chordSize, err := strconv.Atoi(size)
+		if err == nil && chordSize >= int(fretboard.ChordSizeTriad) && chordSize <= int(fretboard.ChordSizeThirteenth) {
+			req.chordSize = fretboard.ChordSize(chordSize)
+		}
+	}
+	if hideOpen, err := strconv.ParseBool(query.Get("hideOpenStrings")); err == nil {
+		req.hideOpen = hideOpen
+	}
+	if format, ok := renderer.DefaultFormats.Lookup(query.Get("format")); ok {
+		req.format = format
+	}
+	if notes := query.Get("notesPerString"); notes != "" {
+		notesPerString, err := strconv.Atoi(notes)
+		if err == nil && notesPerString > 0 {
+			req.tab.NotesPerString = uint(notesPerString)
+		}
+	}
+	if direction, err := fretboard.ParseTabDirection(query.Get("direction")); err == nil {
+		req.tab.Direction = direction
+	}
 	if display := query.Get("displayMode"); display != "" {
 		displayMode, err := strconv.Atoi(display)
 		if err == nil && displayMode > 0 {
@@ -127,17 +456,17 @@ func parseGetScaleRequest(r *http.Request) getScaleRequest {
 }
 
 func buildFretboard(request getScaleRequest) (*fretboard.Fretboard, error) {
-	tuning, err := fretboard.NewTuning(request.tuning)
+	options, err := buildFretboardOptions(request)
 	if err != nil {
 		return nil, err
 	}
 
-	fb, err := fretboard.New(fretboard.Options{Frets: request.frets, Tuning: tuning})
+	fb, err := fretboard.New(options)
 	if err != nil {
 		return nil, err
 	}
 
-	scale, err := fretboard.NewScale(request.rootNote, request.scaleType)
+	scale, err := buildScale(request)
 	if err != nil {
 		return nil, err
 	}
@@ -154,3 +483,51 @@ func buildFretboard(request getScaleRequest) (*fretboard.Fretboard, error) {
 
 	return fb, nil
 }
+
+func buildFretboardOptions(request getScaleRequest) (fretboard.Options, error) {
+	tuning, err := fretboard.ParseTuning(request.tuning, fretboard.NoteParseOptions{Naming: request.naming})
+	if err != nil {
+		return fretboard.Options{}, err
+	}
+
+	options := fretboard.Options{
+		Frets:     request.frets,
+		StartFret: request.startFret,
+		EndFret:   request.endFret,
+		Capo:      request.capo,
+		Tuning:    tuning,
+	}
+	if request.stringCapos != "" {
+		options.StringCapos, err = fretboard.ParseStringCapos(request.stringCapos)
+		if err != nil {
+			return fretboard.Options{}, err
+		}
+	}
+
+	return options, nil
+}
+
+func buildScale(request getScaleRequest) (fretboard.Scale, error) {
+	if request.formula != "" {
+		return fretboard.NewScaleFromFormula(request.rootNote, request.scaleType, request.formula)
+	}
+
+	return fretboard.NewScale(request.rootNote, request.scaleType)
+}
+
+func renderPicture(fb *fretboard.Fretboard, request getScaleRequest) (string, error) {
+	options := renderer.Options{
+		FretboardOffsetX:          0,
+		FretboardOffsetY:          40.0,
+		DrawTitle:                 false,
+		TextDisplayMode:           request.displayMode,
+		HideOutOfScaleOpenStrings: request.hideOpen,
+	}
+	var buf bytes.Buffer
+	err := request.format.New(fb, options).Render(&buf)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
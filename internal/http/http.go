@@ -42,6 +42,10 @@ func NewApplication(addr string, embeddedFiles embed.FS) (Application, error) {
 	router := http.NewServeMux()
 	router.HandleFunc("/", app.handleGetIndex)
 	router.HandleFunc("/api/scale", app.handleGetScale)
+	router.HandleFunc("/api/scales", app.handleGetScales)
+	router.HandleFunc("/api/scales/find", app.handleGetFindScales)
+	router.HandleFunc("/api/identify", app.handleGetIdentify)
+	router.HandleFunc("/api/progression", app.handleGetProgression)
 	router.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.FS(staticFiles))))
 	app.server.Handler = router
 
@@ -60,5 +64,5 @@ func (a Application) internalServerError(err error, w http.ResponseWriter) {
 
 func (a Application) badRequest(err error, w http.ResponseWriter) {
 	a.errorLog.Println(err)
-	http.Error(w, "Bad Request", http.StatusBadRequest)
+	http.Error(w, err.Error(), http.StatusBadRequest)
 }
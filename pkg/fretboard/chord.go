@@ -1,99 +1,404 @@
 package fretboard
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 var (
-	chordMajor7              = "maj7"
-	chordMinor7              = "min7"
-	chordDominant7           = "7"
-	chordHalfDiminished7     = "min7b5"
-	intervalsMajor7          = []uint{4, 7, 11}
-	intervalsMinor7          = []uint{3, 7, 10}
-	intervalsDominant7       = []uint{4, 7, 10}
-	intervalsHalfDiminished7 = []uint{3, 6, 10}
+	chordMajor7               = "maj7"
+	chordMinor7               = "min7"
+	chordDominant7            = "7"
+	chordHalfDiminished7      = "min7b5"
+	chordMinorMajor7          = "minMaj7"
+	chordAugmentedMajor7      = "maj7#5"
+	chordAugmented7           = "7#5"
+	chordDiminished7          = "dim7"
+	chordDiminishedMajor7     = "dimMaj7"
+	intervalsMajor7           = []uint{4, 7, 11}
+	intervalsMinor7           = []uint{3, 7, 10}
+	intervalsDominant7        = []uint{4, 7, 10}
+	intervalsHalfDiminished7  = []uint{3, 6, 10}
+	intervalsMinorMajor7      = []uint{3, 7, 11}
+	intervalsAugmentedMajor7  = []uint{4, 8, 11}
+	intervalsAugmented7       = []uint{4, 8, 10}
+	intervalsDiminished7      = []uint{3, 6, 9}
+	intervalsDiminishedMajor7 = []uint{3, 6, 11}
+	chordTypes                = []chordType{
+		newChordType("", "1 3 5"),
+		newChordType("min", "1 b3 5"),
+		newChordType("dim", "1 b3 b5"),
+		newChordType("aug", "1 3 #5"),
+		newChordType("sus2", "1 2 5"),
+		newChordType("sus4", "1 4 5"),
+		newChordType("5", "1 5"),
+		newChordType("6", "1 3 5 6"),
+		newChordType("min6", "1 b3 5 6"),
+		newChordType("6/9", "1 3 5 6 9"),
+		newChordType("add9", "1 3 5 9"),
+		newChordType("minAdd9", "1 b3 5 9"),
+		newChordType(chordMajor7, "1 3 5 7"),
+		newChordType(chordMinor7, "1 b3 5 b7"),
+		newChordType(chordDominant7, "1 3 5 b7"),
+		newChordType(chordHalfDiminished7, "1 b3 b5 b7"),
+		newChordType(chordMinorMajor7, "1 b3 5 7"),
+		newChordType(chordAugmentedMajor7, "1 3 #5 7"),
+		newChordType(chordAugmented7, "1 3 #5 b7"),
+		newChordType(chordDiminished7, "1 b3 b5 bb7"),
+		newChordType(chordDiminishedMajor7, "1 b3 b5 7"),
+		newChordType("7b5", "1 3 b5 b7"),
+		newChordType("7sus4", "1 4 5 b7"),
+		newChordType("maj9", "1 3 5 7 9"),
+		newChordType("min9", "1 b3 5 b7 9"),
+		newChordType("9", "1 3 5 b7 9"),
+		newChordType("11", "1 3 5 b7 9 11"),
+		newChordType("min11", "1 b3 5 b7 9 11"),
+		newChordType("maj13", "1 3 5 7 9 13"),
+		newChordType("min13", "1 b3 5 b7 9 11 13"),
+		newChordType("13", "1 3 5 b7 9 13"),
+		newChordType("7b9", "1 3 5 b7 b9"),
+		newChordType("7#9", "1 3 5 b7 #9"),
+		newChordType("7#11", "1 3 5 b7 #11"),
+		newChordType("7b13", "1 3 5 b7 b13"),
+		newChordType("maj7#11", "1 3 5 7 #11"),
+	}
 )
 
-func ParseChord(name string) (Chord, error) {
-	var err error
-	var root Note
-	var intervals []uint
-
-	switch {
-	case strings.HasSuffix(name, chordMajor7):
-		root, err = NewNote(strings.TrimSuffix(name, chordMajor7))
-		intervals = intervalsMajor7
-	case strings.HasSuffix(name, chordMinor7):
-		root, err = NewNote(strings.TrimSuffix(name, chordMinor7))
-		intervals = intervalsMinor7
-	case strings.HasSuffix(name, chordDominant7):
-		root, err = NewNote(strings.TrimSuffix(name, chordDominant7))
-		intervals = intervalsDominant7
-	case strings.HasSuffix(name, chordHalfDiminished7):
-		root, err = NewNote(strings.TrimSuffix(name, chordHalfDiminished7))
-		intervals = intervalsHalfDiminished7
-	default:
-		return Chord{}, fmt.Errorf("could not create chord from name %s", name)
+type chordType struct {
+	suffix    string
+	degrees   []degree
+	intervals []uint
+}
+
+func (ct chordType) isTertian(size int) bool {
+	if len(ct.degrees) != size {
+		return false
 	}
 
+	for i, d := range ct.degrees {
+		if d.number != 2*i+1 {
+			return false
+		}
+	}
+	return true
+}
+
+func newChordType(suffix string, formula string) chordType {
+	degrees, err := parseChordFormula(formula)
 	if err != nil {
-		return Chord{}, fmt.Errorf("could not create chord from name %s", name)
+		panic(err)
 	}
 
-	return NewChord(root, intervals...), nil
+	return chordType{suffix: suffix, degrees: degrees, intervals: intervalsFromDegrees(degrees)}
 }
 
 func NewChord(rootNote Note, intervals ...uint) Chord {
+	if ct, ok := identifyChord(intervals); ok {
+		return newChord(rootNote, ct.suffix, ct.degrees)
+	}
+
+	return newChord(rootNote, "", buildChordDegrees(intervals...))
+}
+
+// chordFromDegrees names the chord after the chord table if possible and falls back to the
+// name of its seventh chord with extensions otherwise.
+func chordFromDegrees(root Note, degrees []degree) (Chord, error) {
+	sorted, err := sortChordDegrees(degrees)
+	if err != nil {
+		return Chord{}, err
+	}
+
+	if ct, ok := identifyChord(intervalsFromDegrees(sorted)); ok {
+		return newChord(root, ct.suffix, ct.degrees), nil
+	}
+	return newChord(root, extendedChordSuffix(sorted), sorted), nil
+}
+
+// extendedChordSuffix names a chord larger than a seventh chord by replacing the seventh with
+// its highest natural extension and appending the altered ones, e.g. "min11b5b9".
+func extendedChordSuffix(degrees []degree) string {
+	var core, extensions []degree
+	for _, d := range degrees {
+		if d.number <= 7 {
+			core = append(core, d)
+		} else {
+			extensions = append(extensions, d)
+		}
+	}
+
+	ct, ok := identifyChord(intervalsFromDegrees(core))
+	if !ok || !strings.Contains(ct.suffix, "7") {
+		return ""
+	}
+
+	highest, altered := 7, ""
+	for _, d := range extensions {
+		if d.alteration != 0 {
+			altered += d.String()
+		} else if d.number > highest {
+			highest = d.number
+		}
+	}
+
+	return strings.Replace(ct.suffix, "7", strconv.Itoa(highest), 1) + altered
+}
+
+func newChord(root Note, suffix string, degrees []degree) Chord {
 	return Chord{
-		Name:  fmt.Sprintf("%s%s", rootNote, identifyChord(intervals)),
-		Root:  rootNote,
-		notes: buildChordNotes(rootNote, intervals...),
+		Name:    fmt.Sprintf("%s%s", root, suffix),
+		Root:    root,
+		notes:   buildChordNotes(root, degrees),
+		degrees: degrees,
 	}
 }
 
+// Chord is a set of notes built on Root. Bass is only set for slash chords like C/E, Inversion
+// then tells which chord tone is in the bass (1 for the first inversion and so on) or is -1 if
+// the bass note doesn't belong to the chord, like in C/D.
 type Chord struct {
-	Name  string
-	Root  Note
-	notes []Note
+	Name      string
+	Root      Note
+	Bass      Note
+	Inversion int
+	notes     []Note
+	degrees   []degree
+}
+
+// WithBass turns the chord into a slash chord with the given bass note. A bass note equal to the
+// root gives the chord in root position.
+func (c Chord) WithBass(bass Note) Chord {
+	c.Name = c.baseName()
+	c.Bass, c.Inversion = Note{}, 0
+	if bass.IsZero() || bass.Equals(c.Root) {
+		return c
+	}
+
+	c.Inversion = -1
+	for i, n := range c.notes {
+		if n.Equals(bass) {
+			bass, c.Inversion = n, i
+			break
+		}
+	}
+	c.Bass = bass
+	c.Name = fmt.Sprintf("%s/%s", c.Name, bass)
+
+	return c
+}
+
+// Invert puts the chord tone at the given position in the bass, e.g. 1 for the first inversion.
+func (c Chord) Invert(inversion int) (Chord, error) {
+	if inversion < 0 || inversion >= len(c.notes) {
+		return Chord{}, fmt.Errorf("chord %s has no inversion %d", c.baseName(), inversion)
+	}
+
+	return c.WithBass(c.notes[inversion]), nil
+}
+
+// Notes returns the chord tones starting from the root, followed by the bass note if it isn't
+// a chord tone.
+func (c Chord) Notes() []Note {
+	notes := make([]Note, len(c.notes), len(c.notes)+1)
+	copy(notes, c.notes)
+	if c.Inversion < 0 {
+		notes = append(notes, c.Bass)
+	}
+	return notes
 }
 
 func (c Chord) Contains(n Note) bool {
-	for _, note := range c.notes {
-		if note == n {
+	for _, note := range c.Notes() {
+		if note.Equals(n) {
 			return true
 		}
 	}
 	return false
 }
 
-func buildChordNotes(root Note, intervals ...uint) []Note {
-	notes := make([]Note, len(intervals)+1)
-	notes[0] = root
+func (c Chord) IntervalOf(note Note) (Interval, bool) {
+	for i, n := range c.notes {
+		if n.Equals(note) {
+			return c.degrees[i].interval(), true
+		}
+	}
+	if c.Inversion < 0 && c.Bass.Equals(note) {
+		return c.Root.IntervalTo(c.Bass), true
+	}
+	return Interval{}, false
+}
 
-	for i, v := range intervals {
-		notes[i+1] = root.Add(v)
+func (c Chord) spell(note Note) Note {
+	for _, n := range c.Notes() {
+		if n.Equals(note) {
+			return n
+		}
+	}
+	return note
+}
+
+func (c Chord) hasDegree(d degree) bool {
+	for _, other := range c.degrees {
+		if other == d {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Chord) baseName() string {
+	if c.Bass.IsZero() {
+		return c.Name
+	}
+	return strings.TrimSuffix(c.Name, "/"+c.Bass.String())
+}
+
+func buildChordNotes(root Note, degrees []degree) []Note {
+	notes := make([]Note, len(degrees))
+	for i, d := range degrees {
+		notes[i] = root.atDegree(d)
 	}
 
 	return notes
 }
 
-func identifyChord(intervals []uint) string {
-	var suffix string
-	switch {
-	case reflect.DeepEqual(intervals, intervalsMajor7):
-		suffix = "maj7"
-	case reflect.DeepEqual(intervals, intervalsMinor7):
-		suffix = "min7"
-	case reflect.DeepEqual(intervals, intervalsDominant7):
-		suffix = "7"
-	case reflect.DeepEqual(intervals, intervalsHalfDiminished7):
-		suffix = "min7b5"
-	default:
-		suffix = ""
+func buildChordDegrees(intervals ...uint) []degree {
+	degrees := make([]degree, len(intervals)+1)
+	degrees[0] = degree{number: 1}
+
+	for i, v := range intervals {
+		degrees[i+1] = chordDegree(v, intervals)
+	}
+
+	return degrees
+}
+
+// chordDegree names an interval the way it is used in the chord, e.g. three semitones are a
+// minor third, unless the chord already has a major third, which makes them a sharp ninth.
+func chordDegree(interval uint, intervals []uint) degree {
+	hasMinorThird, hasMajorThird := containsInterval(intervals, 3), containsInterval(intervals, 4)
+	hasThird := hasMinorThird || hasMajorThird
+	hasFifth := containsInterval(intervals, 7)
+	hasSeventh := containsInterval(intervals, 10) || containsInterval(intervals, 11)
+
+	var d degree
+	switch interval % 12 {
+	case 0:
+		d = degree{number: 8}
+	case 1:
+		d = degree{number: 9, alteration: -1}
+	case 2:
+		d = degree{number: 2}
+		if hasThird {
+			d = degree{number: 9}
+		}
+	case 3:
+		d = degree{number: 3, alteration: -1}
+		if hasMajorThird {
+			d = degree{number: 9, alteration: 1}
+		}
+	case 4:
+		d = degree{number: 3}
+	case 5:
+		d = degree{number: 4}
+		if hasThird {
+			d = degree{number: 11}
+		}
+	case 6:
+		d = degree{number: 5, alteration: -1}
+		if hasFifth {
+			d = degree{number: 11, alteration: 1}
+		}
+	case 7:
+		d = degree{number: 5}
+	case 8:
+		d = degree{number: 5, alteration: 1}
+		if hasFifth {
+			d = degree{number: 13, alteration: -1}
+		}
+	case 9:
+		d = degree{number: 6}
+		if hasMinorThird && containsInterval(intervals, 6) && !hasSeventh {
+			d = degree{number: 7, alteration: -2}
+		} else if hasSeventh {
+			d = degree{number: 13}
+		}
+	case 10:
+		d = degree{number: 7, alteration: -1}
+	case 11:
+		d = degree{number: 7}
+	}
+
+	if interval >= 12 && d.number <= 7 {
+		d.number += 7
+	}
+	return d
+}
+
+func containsInterval(intervals []uint, interval uint) bool {
+	for _, i := range intervals {
+		if i == interval {
+			return true
+		}
 	}
+	return false
+}
+
+func identifyChord(intervals []uint) (chordType, bool) {
+	sorted := make([]uint, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
 
-	return suffix
+	for _, ct := range chordTypes {
+		if reflect.DeepEqual(sorted, ct.intervals) {
+			return ct, true
+		}
+	}
+
+	return chordType{}, false
+}
+
+func parseChordFormula(formula string) ([]degree, error) {
+	tokens := strings.Fields(formula)
+	degrees := make([]degree, 0, len(tokens))
+	for _, token := range tokens {
+		d, err := parseDegree(token)
+		if err != nil {
+			return nil, err
+		}
+		degrees = append(degrees, d)
+	}
+
+	return sortChordDegrees(degrees)
+}
+
+func sortChordDegrees(degrees []degree) ([]degree, error) {
+	seen := make(map[int]degree, len(degrees))
+	for _, d := range degrees {
+		pitchClass := d.semitones() % 12
+		if other, ok := seen[pitchClass]; ok {
+			return nil, fmt.Errorf("degrees %s and %s describe the same note", other, d)
+		}
+		seen[pitchClass] = d
+	}
+	if _, ok := seen[0]; !ok {
+		return nil, errors.New("chord must contain the root (1)")
+	}
+
+	sorted := make([]degree, len(degrees))
+	copy(sorted, degrees)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].semitones() < sorted[j].semitones() })
+
+	return sorted, nil
+}
+
+func intervalsFromDegrees(degrees []degree) []uint {
+	intervals := make([]uint, 0, len(degrees)-1)
+	for _, d := range degrees[1:] {
+		intervals = append(intervals, uint(d.semitones()))
+	}
+	return intervals
 }
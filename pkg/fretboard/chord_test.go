@@ -6,7 +6,7 @@ import (
 )
 
 func TestParseChord(t *testing.T) {
-	root := Note{value: "C"}
+	root := mustParseNote("C")
 	t.Run("return correct chord for valid name", func(t *testing.T) {
 		tests := []struct {
 			Name          string
@@ -17,6 +17,11 @@ func TestParseChord(t *testing.T) {
 			{"minor 7", "Cmin7", NewChord(root, intervalsMinor7...)},
 			{"dominant 7", "C7", NewChord(root, intervalsDominant7...)},
 			{"half diminished 7", "Cmin7b5", NewChord(root, intervalsHalfDiminished7...)},
+			{"minor major 7", "CminMaj7", NewChord(root, intervalsMinorMajor7...)},
+			{"augmented major 7", "Cmaj7#5", NewChord(root, intervalsAugmentedMajor7...)},
+			{"augmented 7", "C7#5", NewChord(root, intervalsAugmented7...)},
+			{"diminished 7", "Cdim7", NewChord(root, intervalsDiminished7...)},
+			{"diminished major 7", "CdimMaj7", NewChord(root, intervalsDiminishedMajor7...)},
 		}
 
 		for _, tt := range tests {
@@ -24,10 +29,240 @@ func TestParseChord(t *testing.T) {
 			assert.Equal(t, tt.ExpectedChord, c)
 		}
 	})
+
+	t.Run("parse chord vocabulary and common notations", func(t *testing.T) {
+		tests := []struct {
+			ChordName     string
+			ExpectedName  string
+			ExpectedNotes []string
+		}{
+			{ChordName: "C", ExpectedName: "C", ExpectedNotes: []string{"C", "E", "G"}},
+			{ChordName: "Cmaj", ExpectedName: "C", ExpectedNotes: []string{"C", "E", "G"}},
+			{ChordName: "Cm", ExpectedName: "Cmin", ExpectedNotes: []string{"C", "Eb", "G"}},
+			{ChordName: "C-", ExpectedName: "Cmin", ExpectedNotes: []string{"C", "Eb", "G"}},
+			{ChordName: "Cdim", ExpectedName: "Cdim", ExpectedNotes: []string{"C", "Eb", "Gb"}},
+			{ChordName: "C°", ExpectedName: "Cdim", ExpectedNotes: []string{"C", "Eb", "Gb"}},
+			{ChordName: "Caug", ExpectedName: "Caug", ExpectedNotes: []string{"C", "E", "G#"}},
+			{ChordName: "C+", ExpectedName: "Caug", ExpectedNotes: []string{"C", "E", "G#"}},
+			{ChordName: "Csus2", ExpectedName: "Csus2", ExpectedNotes: []string{"C", "D", "G"}},
+			{ChordName: "Csus", ExpectedName: "Csus4", ExpectedNotes: []string{"C", "F", "G"}},
+			{ChordName: "C5", ExpectedName: "C5", ExpectedNotes: []string{"C", "G"}},
+			{ChordName: "C6", ExpectedName: "C6", ExpectedNotes: []string{"C", "E", "G", "A"}},
+			{ChordName: "Cm6", ExpectedName: "Cmin6", ExpectedNotes: []string{"C", "Eb", "G", "A"}},
+			{ChordName: "C6/9", ExpectedName: "C6/9", ExpectedNotes: []string{"C", "E", "G", "A", "D"}},
+			{ChordName: "Am7", ExpectedName: "Amin7", ExpectedNotes: []string{"A", "C", "E", "G"}},
+			{ChordName: "A-7", ExpectedName: "Amin7", ExpectedNotes: []string{"A", "C", "E", "G"}},
+			{ChordName: "AΔ7", ExpectedName: "Amaj7", ExpectedNotes: []string{"A", "C#", "E", "G#"}},
+			{ChordName: "AΔ", ExpectedName: "Amaj7", ExpectedNotes: []string{"A", "C#", "E", "G#"}},
+			{ChordName: "AM7", ExpectedName: "Amaj7", ExpectedNotes: []string{"A", "C#", "E", "G#"}},
+			{ChordName: "AØ", ExpectedName: "Amin7b5", ExpectedNotes: []string{"A", "C", "Eb", "G"}},
+			{ChordName: "Am7b5", ExpectedName: "Amin7b5", ExpectedNotes: []string{"A", "C", "Eb", "G"}},
+			{ChordName: "A°7", ExpectedName: "Adim7", ExpectedNotes: []string{"A", "C", "Eb", "Gb"}},
+			{ChordName: "Adim7", ExpectedName: "Adim7", ExpectedNotes: []string{"A", "C", "Eb", "Gb"}},
+			{ChordName: "AmMaj7", ExpectedName: "AminMaj7", ExpectedNotes: []string{"A", "C", "E", "G#"}},
+			{ChordName: "Am(maj7)", ExpectedName: "AminMaj7", ExpectedNotes: []string{"A", "C", "E", "G#"}},
+			{ChordName: "Aaug7", ExpectedName: "A7#5", ExpectedNotes: []string{"A", "C#", "E#", "G"}},
+			{ChordName: "A+7", ExpectedName: "A7#5", ExpectedNotes: []string{"A", "C#", "E#", "G"}},
+			{ChordName: "C9", ExpectedName: "C9", ExpectedNotes: []string{"C", "E", "G", "Bb", "D"}},
+			{ChordName: "Cmaj9", ExpectedName: "Cmaj9", ExpectedNotes: []string{"C", "E", "G", "B", "D"}},
+			{ChordName: "Cm9", ExpectedName: "Cmin9", ExpectedNotes: []string{"C", "Eb", "G", "Bb", "D"}},
+			{ChordName: "C11", ExpectedName: "C11", ExpectedNotes: []string{"C", "E", "G", "Bb", "D", "F"}},
+			{ChordName: "C13", ExpectedName: "C13", ExpectedNotes: []string{"C", "E", "G", "Bb", "D", "A"}},
+			{ChordName: "Cadd9", ExpectedName: "Cadd9", ExpectedNotes: []string{"C", "E", "G", "D"}},
+			{ChordName: "C7b9", ExpectedName: "C7b9", ExpectedNotes: []string{"C", "E", "G", "Bb", "Db"}},
+			{ChordName: "C7#9", ExpectedName: "C7#9", ExpectedNotes: []string{"C", "E", "G", "Bb", "D#"}},
+			{ChordName: "C7(#11)", ExpectedName: "C7#11", ExpectedNotes: []string{"C", "E", "G", "Bb", "F#"}},
+			{ChordName: "C7b13", ExpectedName: "C7b13", ExpectedNotes: []string{"C", "E", "G", "Bb", "Ab"}},
+			{ChordName: "C7sus4", ExpectedName: "C7sus4", ExpectedNotes: []string{"C", "F", "G", "Bb"}},
+			{ChordName: "C7(b9, #9)", ExpectedName: "C7b9#9", ExpectedNotes: []string{"C", "E", "G", "Bb", "Db", "D#"}},
+			{ChordName: "C13b9", ExpectedName: "C13b9", ExpectedNotes: []string{"C", "E", "G", "Bb", "Db", "A"}},
+			{ChordName: "Ebm7", ExpectedName: "Ebmin7", ExpectedNotes: []string{"Eb", "Gb", "Bb", "Db"}},
+			{ChordName: "F♯m7", ExpectedName: "F#min7", ExpectedNotes: []string{"F#", "A", "C#", "E"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.ChordName, func(t *testing.T) {
+				c, err := ParseChord(tt.ChordName)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedName, c.Name)
+
+				notes := make([]string, len(c.Notes()))
+				for i, n := range c.Notes() {
+					notes[i] = n.String()
+				}
+				assert.Equal(t, tt.ExpectedNotes, notes)
+			})
+		}
+	})
+
+	t.Run("return the same chord for equivalent names", func(t *testing.T) {
+		expected, _ := ParseChord("Amin7b5")
+		for _, name := range []string{"Am7b5", "A-7b5", "Aø", "Aø7", "Amin7(b5)"} {
+			c, err := ParseChord(name)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, c)
+		}
+	})
+
+	t.Run("parse slash chords", func(t *testing.T) {
+		tests := []struct {
+			ChordName         string
+			ExpectedName      string
+			ExpectedBass      string
+			ExpectedInversion int
+		}{
+			{ChordName: "C/E", ExpectedName: "C/E", ExpectedBass: "E", ExpectedInversion: 1},
+			{ChordName: "C/G", ExpectedName: "C/G", ExpectedBass: "G", ExpectedInversion: 2},
+			{ChordName: "Am7/G", ExpectedName: "Amin7/G", ExpectedBass: "G", ExpectedInversion: 3},
+			{ChordName: "Cm/Eb", ExpectedName: "Cmin/Eb", ExpectedBass: "Eb", ExpectedInversion: 1},
+			{ChordName: "Cm/D#", ExpectedName: "Cmin/Eb", ExpectedBass: "Eb", ExpectedInversion: 1},
+			{ChordName: "C/D", ExpectedName: "C/D", ExpectedBass: "D", ExpectedInversion: -1},
+			{ChordName: "C6/9/E", ExpectedName: "C6/9/E", ExpectedBass: "E", ExpectedInversion: 1},
+			{ChordName: "C/C", ExpectedName: "C", ExpectedBass: "", ExpectedInversion: 0},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.ChordName, func(t *testing.T) {
+				c, err := ParseChord(tt.ChordName)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedName, c.Name)
+				assert.Equal(t, tt.ExpectedBass, c.Bass.String())
+				assert.Equal(t, tt.ExpectedInversion, c.Inversion)
+			})
+		}
+	})
+
+	t.Run("keep 6/9 chords intact", func(t *testing.T) {
+		c, err := ParseChord("C6/9")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "C6/9", c.Name)
+		assert.True(t, c.Bass.IsZero())
+	})
+
+	t.Run("return error for an invalid bass note", func(t *testing.T) {
+		_, err := ParseChord("C/Hb")
+		assert.EqualError(t, err, `could not create chord from name C/Hb: invalid bass note: note does not exist: Hb (expected a note name starting with a letter from A to G)`)
+	})
+
+	t.Run("accept chords with a flat root", func(t *testing.T) {
+		c, err := ParseChord("Bbmin7")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bbmin7", c.Name)
+		assert.True(t, c.Contains(mustParseNote("Db")))
+	})
+
+	t.Run("return error for an unknown chord", func(t *testing.T) {
+		tests := []struct {
+			ChordName     string
+			ExpectedError string
+		}{
+			{ChordName: "Cfoo7", ExpectedError: `could not create chord from name Cfoo7: unexpected "foo7" in "foo7"`},
+			{ChordName: "H7", ExpectedError: "could not create chord from name H7: expected a root note from A to G"},
+			{ChordName: "Cm#9", ExpectedError: "could not create chord from name Cm#9: degrees b3 and #9 describe the same note"},
+			{ChordName: "Cm5", ExpectedError: "could not create chord from name Cm5: power chords can't have a quality"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.ChordName, func(t *testing.T) {
+				_, err := ParseChord(tt.ChordName)
+				assert.EqualError(t, err, tt.ExpectedError)
+			})
+		}
+	})
+}
+
+func TestChord_WithBass(t *testing.T) {
+	t.Run("add a bass note outside of the chord", func(t *testing.T) {
+		c, _ := ParseChord("C")
+		c = c.WithBass(mustParseNote("D"))
+
+		assert.Equal(t, "C/D", c.Name)
+		assert.Equal(t, -1, c.Inversion)
+		assert.True(t, c.Contains(mustParseNote("D")))
+		assert.Equal(t, []Note{mustParseNote("C"), mustParseNote("E"), mustParseNote("G"), mustParseNote("D")}, c.Notes())
+
+		interval, ok := c.IntervalOf(mustParseNote("D"))
+		assert.True(t, ok)
+		assert.Equal(t, "2", interval.String())
+	})
+
+	t.Run("replace an existing bass note", func(t *testing.T) {
+		c, _ := ParseChord("C/D")
+		c = c.WithBass(mustParseNote("G"))
+
+		assert.Equal(t, "C/G", c.Name)
+		assert.Equal(t, 2, c.Inversion)
+		assert.False(t, c.Contains(mustParseNote("D")))
+	})
+
+	t.Run("return to root position", func(t *testing.T) {
+		c, _ := ParseChord("Am7/G")
+		expected, _ := ParseChord("Am7")
+
+		assert.Equal(t, expected, c.WithBass(Note{}))
+	})
+}
+
+func TestChord_Invert(t *testing.T) {
+	t.Run("put the chord tone in the bass", func(t *testing.T) {
+		c, _ := ParseChord("Cmaj7")
+		for inversion, expected := range []string{"Cmaj7", "Cmaj7/E", "Cmaj7/G", "Cmaj7/B"} {
+			inverted, err := c.Invert(inversion)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, inverted.Name)
+			assert.Equal(t, inversion, inverted.Inversion)
+		}
+	})
+
+	t.Run("return error for an inversion the chord doesn't have", func(t *testing.T) {
+		c, _ := ParseChord("C")
+		_, err := c.Invert(3)
+
+		assert.EqualError(t, err, "chord C has no inversion 3")
+	})
+}
+
+func TestChord_Contains(t *testing.T) {
+	c := NewChord(mustParseNote("C"), intervalsMinor7...)
+
+	assert.True(t, c.Contains(mustParseNote("Eb")))
+	assert.True(t, c.Contains(mustParseNote("D#")))
+	assert.False(t, c.Contains(mustParseNote("E")))
+}
+
+func TestChord_IntervalOf(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Intervals        []uint
+		Note             string
+		ExpectedInterval string
+	}{
+		{Name: "minor third in a minor chord", Intervals: []uint{3, 7, 10}, Note: "Eb", ExpectedInterval: "b3"},
+		{Name: "sharp ninth in a dominant chord", Intervals: []uint{4, 7, 10, 15}, Note: "Eb", ExpectedInterval: "#9"},
+		{Name: "ninth in a dominant chord", Intervals: []uint{4, 7, 10, 14}, Note: "D", ExpectedInterval: "9"},
+		{Name: "sharp eleventh in a major chord", Intervals: []uint{4, 7, 11, 18}, Note: "F#", ExpectedInterval: "#11"},
+		{Name: "thirteenth in a dominant chord", Intervals: []uint{4, 7, 10, 21}, Note: "A", ExpectedInterval: "13"},
+		{Name: "diminished fifth in a half diminished chord", Intervals: intervalsHalfDiminished7, Note: "Gb", ExpectedInterval: "b5"},
+		{Name: "diminished seventh in a diminished chord", Intervals: intervalsDiminished7, Note: "A", ExpectedInterval: "bb7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := NewChord(mustParseNote("C"), tt.Intervals...)
+			interval, ok := c.IntervalOf(mustParseNote(tt.Note))
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.ExpectedInterval, interval.String())
+		})
+	}
 }
 
 func TestNewChord(t *testing.T) {
-	root := Note{value: "C"}
+	root := mustParseNote("C")
 
 	t.Run("set correct name for each chord", func(t *testing.T) {
 		tests := []struct {
@@ -39,6 +274,11 @@ func TestNewChord(t *testing.T) {
 			{Name: "minor 7", Intervals: intervalsMinor7, ExpectedName: "Cmin7"},
 			{Name: "dominant 7", Intervals: intervalsDominant7, ExpectedName: "C7"},
 			{Name: "half diminished 7", Intervals: intervalsHalfDiminished7, ExpectedName: "Cmin7b5"},
+			{Name: "minor major 7", Intervals: intervalsMinorMajor7, ExpectedName: "CminMaj7"},
+			{Name: "augmented major 7", Intervals: intervalsAugmentedMajor7, ExpectedName: "Cmaj7#5"},
+			{Name: "augmented 7", Intervals: intervalsAugmented7, ExpectedName: "C7#5"},
+			{Name: "diminished 7", Intervals: intervalsDiminished7, ExpectedName: "Cdim7"},
+			{Name: "diminished major 7", Intervals: intervalsDiminishedMajor7, ExpectedName: "CdimMaj7"},
 		}
 
 		for _, tt := range tests {
@@ -54,17 +294,21 @@ func TestNewChord(t *testing.T) {
 			ExpectedNotes []string
 		}{
 			{Name: "major 7", Intervals: intervalsMajor7, ExpectedNotes: []string{"C", "E", "G", "B"}},
-			{Name: "minor 7", Intervals: intervalsMinor7, ExpectedNotes: []string{"C", "D#", "G", "A#"}},
-			{Name: "dominant 7", Intervals: intervalsDominant7, ExpectedNotes: []string{"C", "E", "G", "A#"}},
-			{Name: "half diminished 7", Intervals: intervalsHalfDiminished7, ExpectedNotes: []string{"C", "D#", "F#", "A#"}},
+			{Name: "minor 7", Intervals: intervalsMinor7, ExpectedNotes: []string{"C", "Eb", "G", "Bb"}},
+			{Name: "dominant 7", Intervals: intervalsDominant7, ExpectedNotes: []string{"C", "E", "G", "Bb"}},
+			{Name: "half diminished 7", Intervals: intervalsHalfDiminished7, ExpectedNotes: []string{"C", "Eb", "Gb", "Bb"}},
+			{Name: "diminished 7", Intervals: intervalsDiminished7, ExpectedNotes: []string{"C", "Eb", "Gb", "Bbb"}},
+			{Name: "augmented major 7", Intervals: intervalsAugmentedMajor7, ExpectedNotes: []string{"C", "E", "G#", "B"}},
 		}
 
 		for _, tt := range tests {
 			t.Run(tt.Name, func(t *testing.T) {
 				c := NewChord(root, tt.Intervals...)
-				for _, n := range tt.ExpectedNotes {
-					assert.True(t, c.Contains(Note{value: n}))
+				notes := make([]string, len(c.Notes()))
+				for i, n := range c.Notes() {
+					notes[i] = n.String()
 				}
+				assert.Equal(t, tt.ExpectedNotes, notes)
 			})
 		}
 	})
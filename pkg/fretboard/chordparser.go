@@ -0,0 +1,335 @@
+package fretboard
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type chordQuality int
+
+const (
+	chordQualityMajor chordQuality = iota
+	chordQualityExplicitMajor
+	chordQualityMinor
+	chordQualityMinorMajor
+	chordQualityDiminished
+	chordQualityDiminishedMajor
+	chordQualityHalfDiminished
+	chordQualityAugmented
+	chordQualityAugmentedMajor
+)
+
+var (
+	chordQualityTokens = []chordQualityToken{
+		{token: "minMaj", quality: chordQualityMinorMajor},
+		{token: "minmaj", quality: chordQualityMinorMajor},
+		{token: "mMaj", quality: chordQualityMinorMajor},
+		{token: "mmaj", quality: chordQualityMinorMajor},
+		{token: "mM", quality: chordQualityMinorMajor},
+		{token: "-maj", quality: chordQualityMinorMajor},
+		{token: "-Δ", quality: chordQualityMinorMajor, impliesSeventh: true},
+		{token: "-∆", quality: chordQualityMinorMajor, impliesSeventh: true},
+		{token: "dimMaj", quality: chordQualityDiminishedMajor},
+		{token: "dimmaj", quality: chordQualityDiminishedMajor},
+		{token: "augMaj", quality: chordQualityAugmentedMajor},
+		{token: "augmaj", quality: chordQualityAugmentedMajor},
+		{token: "+maj", quality: chordQualityAugmentedMajor},
+		{token: "+M", quality: chordQualityAugmentedMajor},
+		{token: "+Δ", quality: chordQualityAugmentedMajor, impliesSeventh: true},
+		{token: "maj", quality: chordQualityExplicitMajor},
+		{token: "Maj", quality: chordQualityExplicitMajor},
+		{token: "min", quality: chordQualityMinor},
+		{token: "dim", quality: chordQualityDiminished},
+		{token: "aug", quality: chordQualityAugmented},
+		{token: "mi", quality: chordQualityMinor},
+		{token: "m", quality: chordQualityMinor},
+		{token: "-", quality: chordQualityMinor},
+		{token: "M", quality: chordQualityExplicitMajor},
+		{token: "Δ", quality: chordQualityExplicitMajor, impliesSeventh: true},
+		{token: "∆", quality: chordQualityExplicitMajor, impliesSeventh: true},
+		{token: "°", quality: chordQualityDiminished},
+		{token: "o", quality: chordQualityDiminished},
+		{token: "+", quality: chordQualityAugmented},
+		{token: "ø", quality: chordQualityHalfDiminished, impliesSeventh: true},
+		{token: "Ø", quality: chordQualityHalfDiminished, impliesSeventh: true},
+	}
+	chordExtensions    = []string{"6/9", "69", "13", "11", "9", "7", "6", "5"}
+	chordAlterations   = map[string]int{"b": -1, "♭": -1, "-": -1, "#": 1, "♯": 1, "+": 1}
+	alterableDegrees   = []string{"13", "11", "9", "5"}
+	addableDegrees     = []string{"13", "11", "9", "6", "4", "2"}
+	chordSuffixCleanup = strings.NewReplacer("(", "", ")", "", ",", "", " ", "")
+)
+
+type chordQualityToken struct {
+	token          string
+	quality        chordQuality
+	impliesSeventh bool
+}
+
+// chordSymbol collects the parts of a chord name while it's being parsed. The chord's notes are
+// only derived once the whole name has been read, because later parts like "sus4" or "b5"
+// replace notes that were implied by the quality.
+type chordSymbol struct {
+	quality     chordQuality
+	extension   string
+	third       *degree
+	fifth       *degree
+	alterations []degree
+	additions   []degree
+	omissions   map[int]bool
+}
+
+// ParseChord reads chord names like "Am7", "C7(b9)" or "F#ø", optionally followed by a bass
+// note like in "C/E" or "Am7/G".
+func ParseChord(name string) (Chord, error) {
+	symbol, bass, err := splitChordBass(name)
+	if err != nil {
+		return Chord{}, fmt.Errorf("could not create chord from name %s: %w", name, err)
+	}
+
+	c, err := parseChordSymbol(symbol)
+	if err != nil {
+		return Chord{}, fmt.Errorf("could not create chord from name %s: %w", name, err)
+	}
+
+	return c.WithBass(bass), nil
+}
+
+// splitChordBass separates the bass note of a slash chord from the chord symbol. Only a letter
+// after the slash starts a bass note, so "6/9" stays part of the symbol.
+func splitChordBass(name string) (string, Note, error) {
+	i := strings.LastIndex(name, "/")
+	if i < 0 {
+		return name, Note{}, nil
+	}
+
+	r, _ := utf8.DecodeRuneInString(strings.TrimSpace(name[i+1:]))
+	if !unicode.IsLetter(r) {
+		return name, Note{}, nil
+	}
+
+	bass, err := NewNote(name[i+1:])
+	if err != nil {
+		return "", Note{}, fmt.Errorf("invalid bass note: %w", err)
+	}
+
+	return name[:i], bass, nil
+}
+
+func parseChordSymbol(name string) (Chord, error) {
+	root, suffix, err := splitChordRoot(name)
+	if err != nil {
+		return Chord{}, err
+	}
+
+	symbol, err := parseChordSuffix(suffix)
+	if err != nil {
+		return Chord{}, err
+	}
+
+	degrees, err := symbol.degrees()
+	if err != nil {
+		return Chord{}, err
+	}
+
+	if ct, ok := identifyChord(intervalsFromDegrees(degrees)); ok {
+		return newChord(root, ct.suffix, ct.degrees), nil
+	}
+	return newChord(root, suffix, degrees), nil
+}
+
+func splitChordRoot(name string) (Note, string, error) {
+	input := strings.TrimSpace(name)
+	r, size := utf8.DecodeRuneInString(input)
+	if !strings.ContainsRune(letters, unicode.ToUpper(r)) {
+		return Note{}, "", errors.New("expected a root note from A to G")
+	}
+
+	end := size
+	for accidentals := 0; accidentals < 2 && end < len(input); accidentals++ {
+		r, size := utf8.DecodeRuneInString(input[end:])
+		if r != '#' && r != 'b' && r != '♯' && r != '♭' {
+			break
+		}
+		end += size
+	}
+
+	root, err := NewNote(input[:end])
+	if err != nil {
+		return Note{}, "", err
+	}
+
+	return root, chordSuffixCleanup.Replace(input[end:]), nil
+}
+
+func parseChordSuffix(suffix string) (chordSymbol, error) {
+	symbol := chordSymbol{omissions: make(map[int]bool)}
+	rest := suffix
+
+	impliesSeventh := false
+	for _, q := range chordQualityTokens {
+		if strings.HasPrefix(rest, q.token) {
+			symbol.quality = q.quality
+			impliesSeventh = q.impliesSeventh
+			rest = rest[len(q.token):]
+			break
+		}
+	}
+
+	for _, e := range chordExtensions {
+		if strings.HasPrefix(rest, e) {
+			symbol.extension = e
+			rest = rest[len(e):]
+			break
+		}
+	}
+	if symbol.extension == "" && impliesSeventh {
+		symbol.extension = "7"
+	}
+	if symbol.extension == "5" && symbol.quality != chordQualityMajor {
+		return chordSymbol{}, fmt.Errorf("power chords can't have a quality")
+	}
+
+	for rest != "" {
+		var err error
+		rest, err = symbol.parseModifier(rest)
+		if err != nil {
+			return chordSymbol{}, fmt.Errorf("%w in %q", err, suffix)
+		}
+	}
+
+	return symbol, nil
+}
+
+func (s *chordSymbol) parseModifier(rest string) (string, error) {
+	switch {
+	case strings.HasPrefix(rest, "sus2"):
+		s.third = &degree{number: 2}
+		return rest[len("sus2"):], nil
+	case strings.HasPrefix(rest, "sus4"):
+		s.third = &degree{number: 4}
+		return rest[len("sus4"):], nil
+	case strings.HasPrefix(rest, "sus"):
+		s.third = &degree{number: 4}
+		return rest[len("sus"):], nil
+	case strings.HasPrefix(rest, "add"):
+		alteration, number, remaining, ok := parseAlteredNumber(rest[len("add"):], addableDegrees)
+		if !ok {
+			return "", fmt.Errorf("unexpected %q", rest)
+		}
+		s.additions = append(s.additions, degree{number: number, alteration: alteration})
+		return remaining, nil
+	case strings.HasPrefix(rest, "no3"), strings.HasPrefix(rest, "no5"):
+		number, _ := strconv.Atoi(rest[2:3])
+		s.omissions[number] = true
+		return rest[3:], nil
+	}
+
+	alteration, number, remaining, ok := parseAlteredNumber(rest, alterableDegrees)
+	if !ok || alteration == 0 {
+		return "", fmt.Errorf("unexpected %q", rest)
+	}
+
+	if number == 5 {
+		s.fifth = &degree{number: 5, alteration: alteration}
+	} else {
+		s.alterations = append(s.alterations, degree{number: number, alteration: alteration})
+	}
+	return remaining, nil
+}
+
+func parseAlteredNumber(s string, numbers []string) (int, int, string, bool) {
+	alteration := 0
+	for token, a := range chordAlterations {
+		if strings.HasPrefix(s, token) {
+			alteration = a
+			s = s[len(token):]
+			break
+		}
+	}
+
+	for _, n := range numbers {
+		if strings.HasPrefix(s, n) {
+			number, _ := strconv.Atoi(n)
+			return alteration, number, s[len(n):], true
+		}
+	}
+
+	return 0, 0, s, false
+}
+
+func (s chordSymbol) degrees() ([]degree, error) {
+	third, fifth := degree{number: 3}, degree{number: 5}
+	switch s.quality {
+	case chordQualityMinor, chordQualityMinorMajor:
+		third.alteration = -1
+	case chordQualityDiminished, chordQualityDiminishedMajor, chordQualityHalfDiminished:
+		third.alteration, fifth.alteration = -1, -1
+	case chordQualityAugmented, chordQualityAugmentedMajor:
+		fifth.alteration = 1
+	}
+	if s.third != nil {
+		third = *s.third
+	}
+	if s.fifth != nil {
+		fifth = *s.fifth
+	}
+
+	seventh := degree{number: 7, alteration: -1}
+	switch s.quality {
+	case chordQualityExplicitMajor, chordQualityMinorMajor, chordQualityDiminishedMajor, chordQualityAugmentedMajor:
+		seventh.alteration = 0
+	case chordQualityDiminished:
+		seventh.alteration = -2
+	}
+
+	degrees := []degree{{number: 1}}
+	if !s.omissions[3] && s.extension != "5" {
+		degrees = append(degrees, third)
+	}
+	if !s.omissions[5] {
+		degrees = append(degrees, fifth)
+	}
+
+	var extensions []degree
+	switch s.extension {
+	case "7":
+		extensions = []degree{seventh}
+	case "9":
+		extensions = []degree{seventh, {number: 9}}
+	case "11":
+		extensions = []degree{seventh, {number: 9}, {number: 11}}
+	case "13":
+		extensions = []degree{seventh, {number: 9}, {number: 13}}
+		if third.alteration == -1 {
+			extensions = append(extensions, degree{number: 11})
+		}
+	case "6":
+		extensions = []degree{{number: 6}}
+	case "6/9", "69":
+		extensions = []degree{{number: 6}, {number: 9}}
+	}
+
+	for _, a := range s.alterations {
+		extensions = removeDegree(extensions, degree{number: a.number})
+	}
+	degrees = append(degrees, extensions...)
+	degrees = append(degrees, s.alterations...)
+	degrees = append(degrees, s.additions...)
+
+	return sortChordDegrees(degrees)
+}
+
+func removeDegree(degrees []degree, d degree) []degree {
+	result := make([]degree, 0, len(degrees))
+	for _, other := range degrees {
+		if other != d {
+			result = append(result, other)
+		}
+	}
+	return result
+}
@@ -0,0 +1,171 @@
+package fretboard
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ScaleQuery lists the notes and chords a scale has to contain.
+type ScaleQuery struct {
+	Notes  []Note
+	Chords []Chord
+}
+
+// ScaleMatch is a scale that contains every note of a ScaleQuery. OtherNotes are the notes of
+// the scale that weren't asked for, Tonic is set if the scale starts on the first note or on
+// the root of one of the chords.
+type ScaleMatch struct {
+	Scale      Scale
+	OtherNotes []Note
+	Tonic      bool
+	order      int
+}
+
+// ParseScaleQuery reads notes and chord names separated by spaces or commas, e.g.
+// "Am7 D7 Gmaj7" or "C D Eb". Everything that is a valid note is taken as a single note.
+func ParseScaleQuery(query string) (ScaleQuery, error) {
+	fields := strings.FieldsFunc(query, func(r rune) bool { return r == ' ' || r == ',' })
+	if len(fields) == 0 {
+		return ScaleQuery{}, errors.New("query must contain at least one note or chord")
+	}
+
+	var q ScaleQuery
+	for _, f := range fields {
+		if n, err := NewNote(f); err == nil {
+			q.Notes = append(q.Notes, n)
+			continue
+		}
+
+		c, err := ParseChord(f)
+		if err != nil {
+			return ScaleQuery{}, fmt.Errorf("%s is neither a note nor a chord: %w", f, err)
+		}
+		q.Chords = append(q.Chords, c)
+	}
+
+	return q, nil
+}
+
+// FindScales searches the default registry, see ScaleRegistry.FindScales.
+func FindScales(query ScaleQuery) []ScaleMatch {
+	return DefaultScales.FindScales(query)
+}
+
+// FindScales returns every registered scale on every root that contains the query. Scales
+// starting on a tonic come first, then scales with fewer other notes, then the scales in the
+// order they were registered.
+func (r *ScaleRegistry) FindScales(query ScaleQuery) []ScaleMatch {
+	notes := query.notes()
+	if len(notes) == 0 {
+		return nil
+	}
+
+	var matches []ScaleMatch
+	for order, definition := range r.Definitions() {
+		for pitchClass := 0; pitchClass < 12; pitchClass++ {
+			s, ok := bestSpelledScale(definition, pitchClass, notes)
+			if !ok {
+				continue
+			}
+
+			match := ScaleMatch{Scale: s, Tonic: query.hasTonic(s.Root), order: order}
+			for _, n := range s.notes {
+				if !containsNote(notes, n) {
+					match.OtherNotes = append(match.OtherNotes, n)
+				}
+			}
+			matches = append(matches, match)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Tonic != matches[j].Tonic {
+			return matches[i].Tonic
+		}
+		if len(matches[i].OtherNotes) != len(matches[j].OtherNotes) {
+			return len(matches[i].OtherNotes) < len(matches[j].OtherNotes)
+		}
+		return matches[i].order < matches[j].order
+	})
+
+	return matches
+}
+
+func (q ScaleQuery) notes() []Note {
+	var notes []Note
+	for _, n := range q.Notes {
+		if !containsNote(notes, n) {
+			notes = append(notes, n)
+		}
+	}
+	for _, c := range q.Chords {
+		for _, n := range c.Notes() {
+			if !containsNote(notes, n) {
+				notes = append(notes, n)
+			}
+		}
+	}
+	return notes
+}
+
+func (q ScaleQuery) hasTonic(root Note) bool {
+	if len(q.Notes) > 0 && q.Notes[0].Equals(root) {
+		return true
+	}
+	for _, c := range q.Chords {
+		if c.Root.Equals(root) {
+			return true
+		}
+	}
+	return false
+}
+
+// bestSpelledScale builds the scale on the given pitch class if it contains all notes.
+func bestSpelledScale(definition ScaleDefinition, pitchClass int, notes []Note) (Scale, bool) {
+	s := spelledScale(definition, pitchClass, notes)
+	if !s.containsAll(notes) {
+		return Scale{}, false
+	}
+	return s, true
+}
+
+// spelledScale builds the scale on the given pitch class. Roots on black keys are tried with a
+// sharp and a flat, the spelling that keeps more of the notes as they were given wins, then the
+// one with fewer accidentals.
+func spelledScale(definition ScaleDefinition, pitchClass int, notes []Note) Scale {
+	var best Scale
+	bestRespelled, bestAccidentals := 0, 0
+	for _, root := range []Note{sharpNotes[pitchClass], flatNotes[pitchClass]} {
+		if !best.Root.IsZero() && root == best.Root {
+			continue
+		}
+		s := newScaleFromDegrees(root, definition.Name, definition.degrees)
+
+		respelled := 0
+		for _, n := range notes {
+			if s.Contains(n) && s.spell(n) != n {
+				respelled++
+			}
+		}
+
+		accidentals := 0
+		for _, n := range s.notes {
+			accidentals += abs(n.accidental)
+		}
+
+		if best.Root.IsZero() || respelled < bestRespelled || (respelled == bestRespelled && accidentals < bestAccidentals) {
+			best, bestRespelled, bestAccidentals = s, respelled, accidentals
+		}
+	}
+
+	return best
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,89 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseScaleQuery(t *testing.T) {
+	t.Run("separate notes from chords", func(t *testing.T) {
+		q, err := ParseScaleQuery("Am7, D7 F# Bb")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Note{mustParseNote("F#"), mustParseNote("Bb")}, q.Notes)
+		assert.Len(t, q.Chords, 2)
+		assert.Equal(t, "Amin7", q.Chords[0].Name)
+		assert.Equal(t, "D7", q.Chords[1].Name)
+	})
+
+	t.Run("return error for an empty query", func(t *testing.T) {
+		_, err := ParseScaleQuery(" , ")
+		assert.EqualError(t, err, "query must contain at least one note or chord")
+	})
+
+	t.Run("return error for something that is neither a note nor a chord", func(t *testing.T) {
+		_, err := ParseScaleQuery("C Xm7")
+		assert.EqualError(t, err, "Xm7 is neither a note nor a chord: could not create chord from name Xm7: expected a root note from A to G")
+	})
+}
+
+func TestFindScales(t *testing.T) {
+	t.Run("rank the scales on the chords' roots first", func(t *testing.T) {
+		q, _ := ParseScaleQuery("Am7 D7 Gmaj7")
+		matches := FindScales(q)
+
+		names := make([]string, len(matches))
+		for i, m := range matches {
+			names[i] = m.Scale.Name()
+		}
+		assert.Equal(t, []string{
+			"G major", "A dorian", "D mixolydian",
+			"B phrygian", "C lydian", "E minor", "F# locrian",
+		}, names)
+		assert.Empty(t, matches[0].OtherNotes)
+		assert.True(t, matches[0].Tonic)
+		assert.False(t, matches[3].Tonic)
+	})
+
+	t.Run("prefer scales with fewer other notes", func(t *testing.T) {
+		q, _ := ParseScaleQuery("C E G")
+		matches := FindScales(q)
+
+		assert.Equal(t, "C major pentatonic", matches[0].Scale.Name())
+		assert.Equal(t, []Note{mustParseNote("D"), mustParseNote("A")}, matches[0].OtherNotes)
+		assert.Equal(t, "C major", matches[1].Scale.Name())
+	})
+
+	t.Run("only return scales containing every note", func(t *testing.T) {
+		q, _ := ParseScaleQuery("C D Eb F G Ab B")
+		for _, m := range FindScales(q) {
+			for _, n := range q.Notes {
+				assert.True(t, m.Scale.Contains(n), "%s should contain %s", m.Scale.Name(), n)
+			}
+		}
+	})
+
+	t.Run("spell the root like the notes", func(t *testing.T) {
+		q, _ := ParseScaleQuery("Gb Bb Db")
+		assert.Equal(t, "Gb major pentatonic", FindScales(q)[0].Scale.Name())
+
+		q, _ = ParseScaleQuery("F# A# C#")
+		assert.Equal(t, "F# major pentatonic", FindScales(q)[0].Scale.Name())
+	})
+
+	t.Run("search a custom registry", func(t *testing.T) {
+		r := NewScaleRegistry()
+		_ = r.Register(ScaleDefinition{Name: "hijaz", Formula: "1 b2 3 4 5 b6 b7"})
+
+		q, _ := ParseScaleQuery("E F G#")
+		matches := r.FindScales(q)
+
+		assert.Len(t, matches, 2)
+		assert.Equal(t, "E hijaz", matches[0].Scale.Name())
+		assert.Equal(t, "C hijaz", matches[1].Scale.Name())
+	})
+
+	t.Run("return nothing for an empty query", func(t *testing.T) {
+		assert.Empty(t, FindScales(ScaleQuery{}))
+	})
+}
@@ -0,0 +1,127 @@
+package fretboard
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	majorScaleSemitones = []int{0, 2, 4, 5, 7, 9, 11}
+	defaultDegrees      = []degree{
+		{number: 1}, {number: 2, alteration: -1}, {number: 2}, {number: 3, alteration: -1},
+		{number: 3}, {number: 4}, {number: 5, alteration: -1}, {number: 5},
+		{number: 6, alteration: -1}, {number: 6}, {number: 7, alteration: -1}, {number: 7},
+	}
+)
+
+type degree struct {
+	number     int
+	alteration int
+}
+
+func (d degree) semitones() int {
+	octaves := (d.number - 1) / 7
+	return majorScaleSemitones[(d.number-1)%7] + 12*octaves + d.alteration
+}
+
+func (d degree) String() string {
+	var accidentals string
+	switch {
+	case d.alteration > 0:
+		accidentals = strings.Repeat("#", d.alteration)
+	case d.alteration < 0:
+		accidentals = strings.Repeat("b", -d.alteration)
+	}
+
+	return accidentals + strconv.Itoa(d.number)
+}
+
+func parseFormula(formula string) ([]degree, error) {
+	tokens := strings.Fields(strings.ReplaceAll(formula, ",", " "))
+	if len(tokens) == 0 {
+		return nil, errors.New("formula must not be empty")
+	}
+
+	degrees := make([]degree, 0, len(tokens))
+	for _, token := range tokens {
+		d, err := parseDegree(token)
+		if err != nil {
+			return nil, err
+		}
+		degrees = append(degrees, d)
+	}
+
+	return normalizeDegrees(degrees)
+}
+
+func parseDegree(token string) (degree, error) {
+	number := strings.TrimLeft(token, "b#")
+	accidentals := token[:len(token)-len(number)]
+	if strings.Contains(accidentals, "b") && strings.Contains(accidentals, "#") {
+		return degree{}, fmt.Errorf("degree %s mixes flats and sharps", token)
+	}
+	if len(accidentals) > 2 {
+		return degree{}, fmt.Errorf("degree %s has more than two accidentals", token)
+	}
+
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return degree{}, fmt.Errorf("degree %s is not a number with optional b or # prefix", token)
+	}
+	if n < 1 || n > 13 {
+		return degree{}, fmt.Errorf("degree %s is out of range, must be between 1 and 13", token)
+	}
+
+	d := degree{number: n, alteration: len(accidentals)}
+	if strings.HasPrefix(accidentals, "b") {
+		d.alteration = -len(accidentals)
+	}
+	if d.semitones() < 0 {
+		return degree{}, fmt.Errorf("degree %s is below the root", token)
+	}
+
+	return d, nil
+}
+
+func degreesFromSemitones(semitones ...uint) ([]degree, error) {
+	degrees := make([]degree, 0, len(semitones)+1)
+	degrees = append(degrees, degree{number: 1})
+	for _, s := range semitones {
+		if s%12 == 0 {
+			continue
+		}
+		degrees = append(degrees, defaultDegrees[s%12])
+	}
+
+	return normalizeDegrees(degrees)
+}
+
+func normalizeDegrees(degrees []degree) ([]degree, error) {
+	seen := make(map[int]degree, len(degrees))
+	hasRoot := false
+	for _, d := range degrees {
+		pitchClass := d.semitones() % 12
+		if other, ok := seen[pitchClass]; ok {
+			return nil, fmt.Errorf("degrees %s and %s describe the same note", other, d)
+		}
+		seen[pitchClass] = d
+
+		if pitchClass == 0 {
+			hasRoot = true
+		}
+	}
+	if !hasRoot {
+		return nil, errors.New("formula must contain the root (1)")
+	}
+
+	sorted := make([]degree, len(degrees))
+	copy(sorted, degrees)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].semitones()%12 < sorted[j].semitones()%12
+	})
+
+	return sorted, nil
+}
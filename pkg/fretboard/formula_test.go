@@ -0,0 +1,68 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseFormula(t *testing.T) {
+	t.Run("return degrees sorted by their distance to the root", func(t *testing.T) {
+		degrees, err := parseFormula("1 5 b3 7 2 4 b6")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []degree{
+			{number: 1},
+			{number: 2},
+			{number: 3, alteration: -1},
+			{number: 4},
+			{number: 5},
+			{number: 6, alteration: -1},
+			{number: 7},
+		}, degrees)
+	})
+
+	t.Run("accept commas and compound degrees", func(t *testing.T) {
+		degrees, err := parseFormula("1, 3, #11, 13")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []degree{{number: 1}, {number: 3}, {number: 11, alteration: 1}, {number: 13}}, degrees)
+	})
+
+	t.Run("return error for an invalid formula", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			Formula       string
+			ExpectedError string
+		}{
+			{Name: "empty formula", Formula: " ", ExpectedError: "formula must not be empty"},
+			{Name: "unknown token", Formula: "1 2 x3", ExpectedError: "degree x3 is not a number with optional b or # prefix"},
+			{Name: "degree out of range", Formula: "1 2 14", ExpectedError: "degree 14 is out of range, must be between 1 and 13"},
+			{Name: "mixed accidentals", Formula: "1 #b3", ExpectedError: "degree #b3 mixes flats and sharps"},
+			{Name: "too many accidentals", Formula: "1 bbb7", ExpectedError: "degree bbb7 has more than two accidentals"},
+			{Name: "below the root", Formula: "1 b1", ExpectedError: "degree b1 is below the root"},
+			{Name: "duplicate note", Formula: "1 #2 b3", ExpectedError: "degrees #2 and b3 describe the same note"},
+			{Name: "missing root", Formula: "2 3 5", ExpectedError: "formula must contain the root (1)"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := parseFormula(tt.Formula)
+				assert.EqualError(t, err, tt.ExpectedError)
+			})
+		}
+	})
+}
+
+func TestDegreesFromSemitones(t *testing.T) {
+	t.Run("add the root and map semitones to degrees", func(t *testing.T) {
+		degrees, err := degreesFromSemitones(3, 5, 7, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []degree{{number: 1}, {number: 3, alteration: -1}, {number: 4}, {number: 5}, {number: 7, alteration: -1}}, degrees)
+	})
+
+	t.Run("return error for duplicate semitones", func(t *testing.T) {
+		_, err := degreesFromSemitones(3, 15)
+		assert.Error(t, err)
+	})
+}
@@ -3,25 +3,42 @@ package fretboard
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
+const lowestTuningPitch = 35
+
 var (
 	TuningStandard = "E A D G B E"
 )
 
+// Fretboard is a neck with Frets frets, of which the frets from StartFret to EndFret are shown.
 type Fretboard struct {
-	Tuning  Tuning
-	Strings uint
-	Frets   uint
-	Scale   Scale
-	Chord   Chord
-	strings []guitarString
+	Tuning    Tuning
+	Strings   uint
+	Frets     uint
+	StartFret uint
+	EndFret   uint
+	Capo      uint
+	Scale     Scale
+	Chord     Chord
+	strings   []guitarString
+	capos     []uint
 }
 
+// Options configure a Fretboard. StartFret and EndFret limit the frets that are shown, e.g. to
+// frets 5 to 9 for a position diagram, and default to the whole neck. Capo is the fret a capo
+// is placed on, 0 meaning no capo. StringCapos places partial or spider capos on single
+// strings, from the lowest to the highest string like the tuning, 0 leaving a string to the
+// full capo.
 type Options struct {
-	Tuning Tuning
-	Frets  uint
+	Tuning      Tuning
+	Frets       uint
+	StartFret   uint
+	EndFret     uint
+	Capo        uint
+	StringCapos []uint
 }
 
 func New(options Options) (*Fretboard, error) {
@@ -32,17 +49,71 @@ func New(options Options) (*Fretboard, error) {
 	if options.Frets == 0 {
 		options.Frets = 22
 	}
+	if options.StartFret == 0 {
+		options.StartFret = 1
+	}
+	if options.EndFret == 0 {
+		options.EndFret = options.Frets
+	}
+	if options.EndFret > options.Frets {
+		return nil, fmt.Errorf("frets %d to %d don't fit on a neck with %d frets", options.StartFret, options.EndFret, options.Frets)
+	}
+	if options.StartFret > options.EndFret {
+		return nil, fmt.Errorf("start fret %d is behind the end fret %d", options.StartFret, options.EndFret)
+	}
+	if len(options.StringCapos) > 0 && uint(len(options.StringCapos)) != options.Tuning.Strings() {
+		return nil, fmt.Errorf("capos are given for %d strings, but the tuning has %d", len(options.StringCapos), options.Tuning.Strings())
+	}
+	for _, capo := range append([]uint{options.Capo}, options.StringCapos...) {
+		if capo >= options.Frets {
+			return nil, fmt.Errorf("capo on fret %d doesn't fit on a neck with %d frets", capo, options.Frets)
+		}
+	}
 
 	f := Fretboard{
-		Tuning:  options.Tuning,
-		Strings: options.Tuning.Strings(),
-		Frets:   options.Frets,
-		strings: buildStringsFromTuning(options.Tuning),
-		Scale:   Scale{},
+		Tuning:    options.Tuning,
+		Strings:   options.Tuning.Strings(),
+		Frets:     options.Frets,
+		StartFret: options.StartFret,
+		EndFret:   options.EndFret,
+		Capo:      options.Capo,
+		strings:   buildStringsFromTuning(options.Tuning),
+		capos:     buildCapos(options),
+		Scale:     Scale{},
 	}
 	return &f, nil
 }
 
+// ParseStringCapos reads the capo fret of every string from the lowest to the highest string,
+// written like a chord shape with 0 or "x" for strings without a capo, e.g. "002220" for a
+// partial capo on the 2nd fret of strings 2 to 4.
+func ParseStringCapos(capos string) ([]uint, error) {
+	tokens := splitShape(capos)
+	if len(tokens) == 0 {
+		return nil, errors.New("capos must be given for every string")
+	}
+
+	frets := make([]uint, len(tokens))
+	for i, token := range tokens {
+		if token == "x" || token == "X" {
+			continue
+		}
+
+		fret, err := strconv.ParseUint(token, 10, 0)
+		if err != nil {
+			return nil, fmt.Errorf("capos %s have an invalid fret %q", capos, token)
+		}
+		frets[i] = uint(fret)
+	}
+
+	return frets, nil
+}
+
+// ShowsNut tells whether the window of shown frets starts at the nut.
+func (f *Fretboard) ShowsNut() bool {
+	return f.StartFret <= 1
+}
+
 func (f *Fretboard) HighlightScale(s Scale) {
 	f.Scale = s
 }
@@ -56,70 +127,180 @@ func (f *Fretboard) Fret(string, fret uint) (Fret, error) {
 		return Fret{}, fmt.Errorf("string %d is invalid", string)
 	}
 
-	note := f.strings[string-1].fret(fret)
+	pitch := f.strings[string-1].fret(fret)
+	note := f.Scale.spell(f.Chord.spell(pitch.Note))
 	return Fret{
 		Number:      fret,
 		Note:        note,
-		Highlighted: f.Scale.Contains(note),
-		Root:        note == f.Scale.Root,
+		Pitch:       pitchWithNote(pitch.MIDI(), note),
+		Highlighted: fret >= f.CapoFret(string) && (f.Scale.Contains(note) || f.Chord.Contains(note)),
+		Root:        note.Equals(f.Scale.Root),
 	}, nil
 }
 
+// CapoFret returns the fret the given string is clamped at by the full or a partial capo, or 0
+// if the string is open.
+func (f *Fretboard) CapoFret(string uint) uint {
+	if string < 1 || int(string) > len(f.capos) {
+		return 0
+	}
+	return f.capos[string-1]
+}
+
+// SoundingTuning returns the tuning of the open strings with the capos applied.
+func (f *Fretboard) SoundingTuning() Tuning {
+	pitches := make([]Pitch, len(f.Tuning.pitches))
+	for i, p := range f.Tuning.pitches {
+		pitches[i] = p.Transpose(int(f.CapoFret(f.Strings - uint(i))))
+	}
+	return Tuning{pitches: pitches}
+}
+
+// ShapeNote returns the note that is played on a fret of the string if the capo is taken as the
+// nut, e.g. the sounding A is a G "shape" with a capo on the 2nd fret. Partial capos only change
+// the shapes of the strings they clamp. The shapes are spelled in the key of the transposed scale
+// or chord.
+func (f *Fretboard) ShapeNote(string uint, n Note) Note {
+	capo := f.CapoFret(string)
+	semitones := -int(capo)
+	switch {
+	case capo == 0:
+		return n
+	case !f.Scale.Root.IsZero():
+		return n.TransposeInterval(f.Scale.Root.IntervalTo(f.Scale.Transpose(semitones).Root))
+	case !f.Chord.Root.IsZero():
+		return n.TransposeInterval(f.Chord.Root.IntervalTo(f.Chord.Transpose(semitones).Root))
+	default:
+		return n.Transpose(semitones)
+	}
+}
+
 func (f *Fretboard) String() string {
-	if title := f.Scale.Name(); title != "" {
-		return title
+	title := f.Scale.Name()
+	if title == "" {
+		title = "Empty fretboard"
+	}
+	if capos := f.describeCapos(); capos != "" {
+		title = fmt.Sprintf("%s (%s)", title, capos)
 	}
 
-	return "Empty fretboard"
+	return title
+}
+
+// describeCapos lists the full capo and the partial capos, e.g. "capo 2 on strings 2-4".
+func (f *Fretboard) describeCapos() string {
+	var capos []string
+	if f.Capo > 0 {
+		capos = append(capos, fmt.Sprintf("capo %d", f.Capo))
+	}
+
+	for first := uint(1); first <= f.Strings; first++ {
+		fret := f.CapoFret(first)
+		if fret <= f.Capo {
+			continue
+		}
+
+		last := first
+		for last < f.Strings && f.CapoFret(last+1) == fret {
+			last++
+		}
+		if first == last {
+			capos = append(capos, fmt.Sprintf("capo %d on string %d", fret, first))
+		} else {
+			capos = append(capos, fmt.Sprintf("capo %d on strings %d-%d", fret, first, last))
+		}
+		first = last
+	}
+
+	return strings.Join(capos, ", ")
 }
 
 type Fret struct {
 	Number      uint
 	Note        Note
+	Pitch       Pitch
 	Highlighted bool
 	Root        bool
 }
 
 type Tuning struct {
-	notes []Note
+	pitches []Pitch
 }
 
 func NewTuning(notes string) (Tuning, error) {
-	noteSlice := strings.Split(notes, " ")
+	return ParseTuning(notes, NoteParseOptions{})
+}
+
+// ParseTuning accepts notes with or without octave, e.g. "E2 A2 D3 G3 B3 E4" or "E A D G B E".
+// Missing octaves are inferred: the lowest string is placed between B1 and A#2, every other
+// string is tuned to the next matching pitch above the previous one.
+func ParseTuning(notes string, options NoteParseOptions) (Tuning, error) {
+	noteSlice := strings.Fields(notes)
 	if len(noteSlice) == 0 {
 		return Tuning{}, errors.New("notes of the tuning must be separated by a space")
 	}
 
-	t := Tuning{notes: make([]Note, len(noteSlice))}
+	t := Tuning{pitches: make([]Pitch, len(noteSlice))}
 	for i, n := range noteSlice {
-		note, err := NewNote(n)
+		pitch, err := ParsePitch(n, options)
+		if err == nil {
+			t.pitches[i] = pitch
+			continue
+		}
+
+		note, err := ParseNote(n, options)
 		if err != nil {
 			return Tuning{}, err
 		}
-		t.notes[i] = note
+
+		lowest := lowestTuningPitch
+		if i > 0 {
+			lowest = t.pitches[i-1].MIDI() + 1
+		}
+		t.pitches[i] = pitchWithNote(lowest+int(PitchFromMIDI(lowest).Note.semitonesTo(note)), note)
 	}
 
 	return t, nil
 }
 
 func (t Tuning) Notes() []Note {
-	return t.notes
+	notes := make([]Note, len(t.pitches))
+	for i, p := range t.pitches {
+		notes[i] = p.Note
+	}
+	return notes
+}
+
+func (t Tuning) Pitches() []Pitch {
+	pitches := make([]Pitch, len(t.pitches))
+	copy(pitches, t.pitches)
+	return pitches
+}
+
+// Transpose moves every string up by the given number of semitones, or down for negative
+// numbers, like a capo or a tuning a half step down.
+func (t Tuning) Transpose(semitones int) Tuning {
+	pitches := make([]Pitch, len(t.pitches))
+	for i, p := range t.pitches {
+		pitches[i] = p.Transpose(semitones)
+	}
+	return Tuning{pitches: pitches}
 }
 
 func (t Tuning) Strings() uint {
-	return uint(len(t.notes))
+	return uint(len(t.pitches))
 }
 
 func (t Tuning) IsZero() bool {
-	return len(t.notes) == 0
+	return len(t.pitches) == 0
 }
 
 type guitarString struct {
-	root   Note
+	root   Pitch
 	number uint
 }
 
-func (g guitarString) fret(fret uint) Note {
+func (g guitarString) fret(fret uint) Pitch {
 	return g.root.Add(fret)
 }
 
@@ -127,14 +308,31 @@ func buildStringsFromTuning(tuning Tuning) []guitarString {
 	guitarStrings := make([]guitarString, tuning.Strings())
 
 	for i := 0; i < int(tuning.Strings()); i++ {
-		rootNote := tuning.notes[i]
+		rootPitch := tuning.pitches[i]
 
 		stringNumber := int(tuning.Strings()) - i
 		guitarStrings[stringNumber-1] = guitarString{
-			root:   rootNote,
+			root:   rootPitch,
 			number: uint(stringNumber),
 		}
 	}
 
 	return guitarStrings
 }
+
+// buildCapos returns the capo fret of every string, indexed like the guitar strings.
+func buildCapos(options Options) []uint {
+	capos := make([]uint, options.Tuning.Strings())
+	for i := range capos {
+		capos[i] = options.Capo
+	}
+
+	for i, capo := range options.StringCapos {
+		stringNumber := len(capos) - i
+		if capo > capos[stringNumber-1] {
+			capos[stringNumber-1] = capo
+		}
+	}
+
+	return capos
+}
@@ -2,6 +2,7 @@ package fretboard
 
 import (
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 )
 
@@ -15,6 +16,65 @@ func TestNewTuning(t *testing.T) {
 		_, err := NewTuning("E A D G B E")
 		assert.NoError(t, err)
 	})
+
+	t.Run("accept lower case notes and repeated spaces", func(t *testing.T) {
+		tuning, err := NewTuning("d  a d g b e")
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(6), tuning.Strings())
+	})
+
+	t.Run("parse notes with the given naming", func(t *testing.T) {
+		tuning, err := ParseTuning("E A D G H E", NoteParseOptions{Naming: NamingGerman})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "B", tuning.Notes()[4].String())
+	})
+
+	t.Run("parse notes with octaves", func(t *testing.T) {
+		tuning, err := NewTuning("E1 A1 D2 G2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Pitch{
+			{Note: mustParseNote("E"), Octave: 1},
+			{Note: mustParseNote("A"), Octave: 1},
+			{Note: mustParseNote("D"), Octave: 2},
+			{Note: mustParseNote("G"), Octave: 2},
+		}, tuning.Pitches())
+	})
+
+	t.Run("infer missing octaves from the previous string", func(t *testing.T) {
+		tests := []struct {
+			Tuning          string
+			ExpectedPitches string
+		}{
+			{Tuning: "E A D G B E", ExpectedPitches: "E2 A2 D3 G3 B3 E4"},
+			{Tuning: "B E A D G B E", ExpectedPitches: "B1 E2 A2 D3 G3 B3 E4"},
+			{Tuning: "C G C F A D", ExpectedPitches: "C2 G2 C3 F3 A3 D4"},
+			{Tuning: "D A D F# A D", ExpectedPitches: "D2 A2 D3 F#3 A3 D4"},
+			{Tuning: "E2 A D G B E", ExpectedPitches: "E2 A2 D3 G3 B3 E4"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Tuning, func(t *testing.T) {
+				tuning, err := NewTuning(tt.Tuning)
+				assert.NoError(t, err)
+
+				pitches := make([]string, 0, tuning.Strings())
+				for _, p := range tuning.Pitches() {
+					pitches = append(pitches, p.String())
+				}
+				assert.Equal(t, tt.ExpectedPitches, strings.Join(pitches, " "))
+			})
+		}
+	})
+
+	t.Run("accept flat note names", func(t *testing.T) {
+		tuning, err := NewTuning("Eb Ab Db Gb Bb Eb")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Ab", tuning.Notes()[1].String())
+	})
 }
 
 func TestTuning_Strings(t *testing.T) {
@@ -26,12 +86,12 @@ func TestTuning_Strings(t *testing.T) {
 func TestTuning_Notes(t *testing.T) {
 	tuning, _ := NewTuning("E A D G B E")
 	expectedNotes := []Note{
-		{value: "E"},
-		{value: "A"},
-		{value: "D"},
-		{value: "G"},
-		{value: "B"},
-		{value: "E"},
+		mustParseNote("E"),
+		mustParseNote("A"),
+		mustParseNote("D"),
+		mustParseNote("G"),
+		mustParseNote("B"),
+		mustParseNote("E"),
 	}
 
 	assert.Equal(t, expectedNotes, tuning.Notes())
@@ -63,6 +123,103 @@ func TestFretboard_String(t *testing.T) {
 
 		assert.Equal(t, "Empty fretboard", fb.String())
 	})
+
+	t.Run("mention the capo in the title", func(t *testing.T) {
+		scale, _ := NewScale("A", ScaleMajor)
+		fb, _ := New(Options{Capo: 2})
+		fb.HighlightScale(scale)
+
+		assert.Equal(t, "A major (capo 2)", fb.String())
+	})
+}
+
+func TestFretboard_Capo(t *testing.T) {
+	aMajor, _ := NewScale("A", ScaleMajor)
+
+	t.Run("return error if the capo is beyond the last fret", func(t *testing.T) {
+		_, err := New(Options{Frets: 12, Capo: 12})
+		assert.EqualError(t, err, "capo on fret 12 doesn't fit on a neck with 12 frets")
+	})
+
+	t.Run("shift the sounding tuning", func(t *testing.T) {
+		fb, _ := New(Options{Capo: 2})
+
+		pitches := make([]string, 0, fb.Strings)
+		for _, p := range fb.SoundingTuning().Pitches() {
+			pitches = append(pitches, p.String())
+		}
+		assert.Equal(t, "F#2 B2 E3 A3 C#4 F#4", strings.Join(pitches, " "))
+		assert.Equal(t, "E A D G B E", joinNoteNames(fb.Tuning.Notes()))
+	})
+
+	t.Run("don't highlight frets behind the capo", func(t *testing.T) {
+		fb, _ := New(Options{Capo: 2})
+		fb.HighlightScale(aMajor)
+
+		behind, _ := fb.Fret(6, 0)
+		capo, _ := fb.Fret(6, 2)
+
+		assert.False(t, behind.Highlighted)
+		assert.True(t, capo.Highlighted)
+	})
+
+	t.Run("return the shape played relative to the capo", func(t *testing.T) {
+		fb, _ := New(Options{Capo: 2})
+		fb.HighlightScale(aMajor)
+
+		assert.Equal(t, "G", fb.ShapeNote(1, mustParseNote("A")).String())
+		assert.Equal(t, "F#", fb.ShapeNote(1, mustParseNote("G#")).String())
+	})
+
+	t.Run("clamp single strings with a partial capo", func(t *testing.T) {
+		fb, err := New(Options{StringCapos: []uint{0, 0, 2, 2, 2, 0}})
+		assert.NoError(t, err)
+		fb.HighlightScale(aMajor)
+
+		assert.Equal(t, []uint{0, 2, 2, 2, 0, 0}, []uint{fb.CapoFret(1), fb.CapoFret(2), fb.CapoFret(3), fb.CapoFret(4), fb.CapoFret(5), fb.CapoFret(6)})
+		assert.Equal(t, "E A E A C# E", joinNoteNames(fb.SoundingTuning().Notes()))
+
+		open, _ := fb.Fret(1, 0)
+		clamped, _ := fb.Fret(2, 0)
+		assert.True(t, open.Highlighted)
+		assert.False(t, clamped.Highlighted)
+		assert.Equal(t, "A major (capo 2 on strings 2-4)", fb.String())
+	})
+
+	t.Run("return the shape played relative to a partial capo", func(t *testing.T) {
+		fb, _ := New(Options{StringCapos: []uint{0, 0, 2, 2, 2, 0}})
+		fb.HighlightScale(aMajor)
+
+		assert.Equal(t, "G", fb.ShapeNote(3, mustParseNote("A")).String())
+		assert.Equal(t, "A", fb.ShapeNote(1, mustParseNote("A")).String())
+		assert.Equal(t, "A", fb.ShapeNote(5, mustParseNote("A")).String())
+	})
+
+	t.Run("combine a full capo with partial capos", func(t *testing.T) {
+		fb, err := New(Options{Capo: 2, StringCapos: []uint{0, 0, 0, 4, 0, 0}})
+		assert.NoError(t, err)
+
+		assert.Equal(t, uint(2), fb.CapoFret(1))
+		assert.Equal(t, uint(4), fb.CapoFret(3))
+		assert.Equal(t, "Empty fretboard (capo 2, capo 4 on string 3)", fb.String())
+	})
+
+	t.Run("return error if partial capos don't match the tuning", func(t *testing.T) {
+		_, err := New(Options{StringCapos: []uint{0, 2, 2, 2}})
+		assert.EqualError(t, err, "capos are given for 4 strings, but the tuning has 6")
+
+		_, err = New(Options{Frets: 12, StringCapos: []uint{0, 0, 14, 0, 0, 0}})
+		assert.EqualError(t, err, "capo on fret 14 doesn't fit on a neck with 12 frets")
+	})
+
+	t.Run("spell shapes in the transposed key", func(t *testing.T) {
+		fMajor, _ := NewScale("F", ScaleMajor)
+		fb, _ := New(Options{Capo: 3})
+		fb.HighlightScale(fMajor)
+
+		assert.Equal(t, "D", fb.ShapeNote(1, mustParseNote("F")).String())
+		assert.Equal(t, "G", fb.ShapeNote(1, mustParseNote("Bb")).String())
+	})
 }
 
 func TestFretboard_Fret(t *testing.T) {
@@ -96,10 +253,44 @@ func TestFretboard_Fret(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Equal(t, uint(5), fret.Number)
-		assert.Equal(t, Note{value: "A"}, fret.Note)
+		assert.Equal(t, mustParseNote("A"), fret.Note)
 		assert.Equal(t, true, fret.Highlighted)
 	})
 
+	t.Run("return the note spelled as in the highlighted scale", func(t *testing.T) {
+		fretboard, _ := New(Options{})
+		scale, _ := NewScale("F", ScaleMajor)
+		fretboard.HighlightScale(scale)
+
+		fret, err := fretboard.Fret(3, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bb", fret.Note.String())
+	})
+
+	t.Run("return the exact pitch of a fret", func(t *testing.T) {
+		fretboard, _ := New(Options{})
+
+		tests := []struct {
+			String        uint
+			Fret          uint
+			ExpectedPitch string
+		}{
+			{String: 6, Fret: 0, ExpectedPitch: "E2"},
+			{String: 6, Fret: 5, ExpectedPitch: "A2"},
+			{String: 1, Fret: 0, ExpectedPitch: "E4"},
+			{String: 1, Fret: 12, ExpectedPitch: "E5"},
+			{String: 2, Fret: 1, ExpectedPitch: "C4"},
+		}
+
+		for _, tt := range tests {
+			fret, err := fretboard.Fret(tt.String, tt.Fret)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ExpectedPitch, fret.Pitch.String())
+		}
+	})
+
 	t.Run("return false for Highlighted if a frets note is not in scale", func(t *testing.T) {
 		tuning, _ := NewTuning(TuningStandard)
 		fretboard, _ := New(Options{Tuning: tuning})
@@ -110,6 +301,29 @@ func TestFretboard_Fret(t *testing.T) {
 		assert.Equal(t, false, fret.Highlighted)
 	})
 
+	t.Run("return true for Highlighted if a frets note is in the chord but not in the scale", func(t *testing.T) {
+		tuning, _ := NewTuning(TuningStandard)
+		fretboard, _ := New(Options{Tuning: tuning})
+		fretboard.HighlightScale(testScale)
+		chord, _ := ParseChord("F7")
+		fretboard.HighlightChord(chord)
+		fret, err := fretboard.Fret(6, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, true, fret.Highlighted)
+	})
+
+	t.Run("highlight open strings like every other fret", func(t *testing.T) {
+		fretboard, _ := New(Options{})
+		fretboard.HighlightScale(testScale)
+
+		inScale, _ := fretboard.Fret(1, 0)
+		outOfScale, _ := fretboard.Fret(3, 0)
+
+		assert.True(t, inScale.Highlighted)
+		assert.False(t, outOfScale.Highlighted)
+	})
+
 	t.Run("return false for Highlighted if the fretboard has no highlighted scale", func(t *testing.T) {
 		tuning, _ := NewTuning(TuningStandard)
 		fretboard, _ := New(Options{Tuning: tuning})
@@ -138,12 +352,12 @@ func TestNewFretboard(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Equal(t, uint(6), fretboard.Strings)
-		assert.Equal(t, "E", fretboard.strings[0].root.String())
-		assert.Equal(t, "B", fretboard.strings[1].root.String())
-		assert.Equal(t, "G", fretboard.strings[2].root.String())
-		assert.Equal(t, "D", fretboard.strings[3].root.String())
-		assert.Equal(t, "A", fretboard.strings[4].root.String())
-		assert.Equal(t, "E", fretboard.strings[5].root.String())
+		assert.Equal(t, "E4", fretboard.strings[0].root.String())
+		assert.Equal(t, "B3", fretboard.strings[1].root.String())
+		assert.Equal(t, "G3", fretboard.strings[2].root.String())
+		assert.Equal(t, "D3", fretboard.strings[3].root.String())
+		assert.Equal(t, "A2", fretboard.strings[4].root.String())
+		assert.Equal(t, "E2", fretboard.strings[5].root.String())
 	})
 
 	t.Run("use 22 frets as default for zero value Options", func(t *testing.T) {
@@ -153,18 +367,44 @@ func TestNewFretboard(t *testing.T) {
 		assert.Equal(t, uint(22), fretboard.Frets)
 	})
 
+	t.Run("show the whole neck for zero value Options", func(t *testing.T) {
+		fretboard, err := New(Options{Frets: 12})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), fretboard.StartFret)
+		assert.Equal(t, uint(12), fretboard.EndFret)
+		assert.True(t, fretboard.ShowsNut())
+	})
+
+	t.Run("show a window of frets", func(t *testing.T) {
+		fretboard, err := New(Options{Frets: 12, StartFret: 5, EndFret: 9})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), fretboard.StartFret)
+		assert.Equal(t, uint(9), fretboard.EndFret)
+		assert.False(t, fretboard.ShowsNut())
+	})
+
+	t.Run("return error for a window beyond the neck or in the wrong order", func(t *testing.T) {
+		_, err := New(Options{Frets: 12, StartFret: 10, EndFret: 15})
+		assert.EqualError(t, err, "frets 10 to 15 don't fit on a neck with 12 frets")
+
+		_, err = New(Options{Frets: 12, StartFret: 9, EndFret: 5})
+		assert.EqualError(t, err, "start fret 9 is behind the end fret 5")
+	})
+
 	t.Run("parse guitar strings from specified tuning", func(t *testing.T) {
 		tuning, _ := NewTuning("D A D G B E")
 
 		fretboard, err := New(Options{Tuning: tuning})
 
 		assert.NoError(t, err)
-		assert.Equal(t, "E", fretboard.strings[0].root.String())
-		assert.Equal(t, "B", fretboard.strings[1].root.String())
-		assert.Equal(t, "G", fretboard.strings[2].root.String())
-		assert.Equal(t, "D", fretboard.strings[3].root.String())
-		assert.Equal(t, "A", fretboard.strings[4].root.String())
-		assert.Equal(t, "D", fretboard.strings[5].root.String())
+		assert.Equal(t, "E4", fretboard.strings[0].root.String())
+		assert.Equal(t, "B3", fretboard.strings[1].root.String())
+		assert.Equal(t, "G3", fretboard.strings[2].root.String())
+		assert.Equal(t, "D3", fretboard.strings[3].root.String())
+		assert.Equal(t, "A2", fretboard.strings[4].root.String())
+		assert.Equal(t, "D2", fretboard.strings[5].root.String())
 
 		assert.Equal(t, uint(1), fretboard.strings[0].number)
 		assert.Equal(t, uint(2), fretboard.strings[1].number)
@@ -174,3 +414,24 @@ func TestNewFretboard(t *testing.T) {
 		assert.Equal(t, uint(6), fretboard.strings[5].number)
 	})
 }
+
+func TestParseStringCapos(t *testing.T) {
+	t.Run("read one fret per string", func(t *testing.T) {
+		capos, err := ParseStringCapos("x02220")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{0, 0, 2, 2, 2, 0}, capos)
+	})
+
+	t.Run("read frets above 9 with separators", func(t *testing.T) {
+		capos, err := ParseStringCapos("0-0-10-10-0-0")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{0, 0, 10, 10, 0, 0}, capos)
+	})
+
+	t.Run("return error for an invalid fret", func(t *testing.T) {
+		_, err := ParseStringCapos("00a220")
+		assert.EqualError(t, err, `capos 00a220 have an invalid fret "a"`)
+	})
+}
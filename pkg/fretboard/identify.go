@@ -0,0 +1,202 @@
+package fretboard
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	penaltyInversion     = 1
+	penaltyMissingTone   = 1
+	penaltyMissingRoot   = 3
+	minimumIncompleteSet = 3
+)
+
+// ChordCandidate is one possible name for a set of notes. Missing lists the chord tones that
+// aren't part of the notes, Rootless is set if the root is one of them.
+type ChordCandidate struct {
+	Chord     Chord
+	Missing   []Interval
+	Rootless  bool
+	penalty   int
+	respelled int
+}
+
+// Position is a fretted note. Strings are numbered like on the Fretboard, 1 being the highest.
+type Position struct {
+	String uint
+	Fret   uint
+}
+
+// IdentifyChord names the chord formed by the given notes, the first note being the bass. The
+// candidates are ranked from the most to the least likely: complete chords in root position
+// come first, followed by inversions, chords with omitted tones and rootless voicings.
+func IdentifyChord(notes ...Note) []ChordCandidate {
+	distinct := make([]Note, 0, len(notes))
+	for _, n := range notes {
+		if !containsNote(distinct, n) {
+			distinct = append(distinct, n)
+		}
+	}
+	if len(distinct) < 2 {
+		return nil
+	}
+
+	var candidates []ChordCandidate
+	seen := make(map[string]bool)
+	for _, ct := range chordTypes {
+		for root := 0; root < 12; root++ {
+			candidate, ok := matchChordType(ct, root, distinct, notes[0])
+			if !ok || seen[candidate.Chord.Name] {
+				continue
+			}
+
+			seen[candidate.Chord.Name] = true
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].penalty != candidates[j].penalty {
+			return candidates[i].penalty < candidates[j].penalty
+		}
+		if candidates[i].respelled != candidates[j].respelled {
+			return candidates[i].respelled < candidates[j].respelled
+		}
+		return len(candidates[i].Chord.notes) < len(candidates[j].Chord.notes)
+	})
+
+	return candidates
+}
+
+// IdentifyChord names the chord played at the given positions, the lowest pitch being the bass.
+func (f *Fretboard) IdentifyChord(positions ...Position) ([]ChordCandidate, error) {
+	pitches := make([]Pitch, 0, len(positions))
+	for _, p := range positions {
+		fret, err := f.Fret(p.String, p.Fret)
+		if err != nil {
+			return nil, err
+		}
+		pitches = append(pitches, fret.Pitch)
+	}
+	sort.SliceStable(pitches, func(i, j int) bool { return pitches[i].MIDI() < pitches[j].MIDI() })
+
+	notes := make([]Note, len(pitches))
+	for i, p := range pitches {
+		notes[i] = p.Note
+	}
+
+	return IdentifyChord(notes...), nil
+}
+
+// ParseShape reads a chord shape from the lowest to the highest string, with "x" marking a muted
+// string. Frets above 9 need a separator, e.g. "x32010" or "x-10-12-12-12-x".
+func (f *Fretboard) ParseShape(shape string) ([]Position, error) {
+	tokens := splitShape(shape)
+	if len(tokens) != int(f.Strings) {
+		return nil, fmt.Errorf("shape %s has %d strings, but the tuning has %d", shape, len(tokens), f.Strings)
+	}
+
+	positions := make([]Position, 0, len(tokens))
+	for i, token := range tokens {
+		if token == "x" || token == "X" {
+			continue
+		}
+
+		fret, err := strconv.ParseUint(token, 10, 0)
+		if err != nil || uint(fret) > f.Frets {
+			return nil, fmt.Errorf("shape %s has an invalid fret %q", shape, token)
+		}
+		positions = append(positions, Position{String: f.Strings - uint(i), Fret: uint(fret)})
+	}
+
+	return positions, nil
+}
+
+// splitShape splits a shape into one token per string, see ParseShape.
+func splitShape(shape string) []string {
+	tokens := strings.FieldsFunc(shape, func(r rune) bool { return r == '-' || r == ',' || r == ' ' })
+	if len(tokens) == 1 {
+		tokens = strings.Split(tokens[0], "")
+	}
+	return tokens
+}
+
+func matchChordType(ct chordType, root int, notes []Note, bass Note) (ChordCandidate, bool) {
+	spelled := make([]Note, len(ct.degrees))
+	for _, n := range notes {
+		i := degreeIndex(ct.degrees, (n.pitchClass()-root+12)%12)
+		if i < 0 {
+			return ChordCandidate{}, false
+		}
+		spelled[i] = n
+	}
+
+	var candidate ChordCandidate
+	var rootNote Note
+	for i, d := range ct.degrees {
+		if !spelled[i].IsZero() {
+			if rootNote.IsZero() {
+				rootNote = spelled[i].rootBelow(d)
+			}
+			continue
+		}
+		if !canBeOmitted(ct, d) || len(notes) < minimumIncompleteSet {
+			return ChordCandidate{}, false
+		}
+
+		candidate.Missing = append(candidate.Missing, d.interval())
+		candidate.penalty += penaltyMissingTone
+		if d.number == 1 {
+			candidate.Rootless = true
+			candidate.penalty += penaltyMissingRoot - penaltyMissingTone
+		}
+	}
+
+	candidate.Chord = newChord(rootNote, ct.suffix, ct.degrees).WithBass(bass)
+	for i, n := range candidate.Chord.notes {
+		if !spelled[i].IsZero() && spelled[i] != n {
+			candidate.respelled++
+		}
+	}
+	if candidate.Chord.Inversion != 0 {
+		candidate.penalty += penaltyInversion
+	}
+
+	return candidate, true
+}
+
+// canBeOmitted tells whether a chord tone may be left out while the chord keeps its name, like
+// the fifth, the root of larger chords or the ninth and eleventh of a thirteenth chord.
+func canBeOmitted(ct chordType, d degree) bool {
+	switch {
+	case d.number == 1:
+		return len(ct.degrees) >= 4
+	case d == degree{number: 5}:
+		return len(ct.degrees) >= 3
+	case (d.number == 9 || d.number == 11) && d.alteration == 0:
+		return ct.degrees[len(ct.degrees)-1].number > d.number
+	default:
+		return false
+	}
+}
+
+func degreeIndex(degrees []degree, pitchClass int) int {
+	for i, d := range degrees {
+		if d.semitones()%12 == pitchClass {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsNote(notes []Note, note Note) bool {
+	for _, n := range notes {
+		if n.Equals(note) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestIdentifyChord(t *testing.T) {
+	t.Run("rank the complete chord in root position first", func(t *testing.T) {
+		tests := []struct {
+			Notes        string
+			ExpectedName string
+		}{
+			{Notes: "C E G", ExpectedName: "C"},
+			{Notes: "A C E G", ExpectedName: "Amin7"},
+			{Notes: "E G Bb D", ExpectedName: "Emin7b5"},
+			{Notes: "C E G A", ExpectedName: "C6"},
+			{Notes: "C Eb Gb A", ExpectedName: "Cdim7"},
+			{Notes: "G B D F Ab", ExpectedName: "G7b9"},
+			{Notes: "C G", ExpectedName: "C5"},
+			{Notes: "E G C", ExpectedName: "C/E"},
+			{Notes: "B D G", ExpectedName: "G/B"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Notes, func(t *testing.T) {
+				candidates := IdentifyChord(mustParseNotes(tt.Notes)...)
+
+				assert.NotEmpty(t, candidates)
+				assert.Equal(t, tt.ExpectedName, candidates[0].Chord.Name)
+				assert.Empty(t, candidates[0].Missing)
+				assert.False(t, candidates[0].Rootless)
+			})
+		}
+	})
+
+	t.Run("list alternative names after the best match", func(t *testing.T) {
+		candidates := IdentifyChord(mustParseNotes("C E G A")...)
+
+		names := make([]string, len(candidates))
+		for i, c := range candidates {
+			names[i] = c.Chord.Name
+		}
+		assert.Equal(t, []string{"C6", "Amin7/C", "Fmaj9/C"}, names)
+	})
+
+	t.Run("identify chords without a fifth", func(t *testing.T) {
+		candidates := IdentifyChord(mustParseNotes("D F# C")...)
+
+		assert.Equal(t, "D7", candidates[0].Chord.Name)
+		assert.Equal(t, []Interval{{Quality: QualityPerfect, Number: 5}}, candidates[0].Missing)
+	})
+
+	t.Run("identify rootless voicings", func(t *testing.T) {
+		candidates := IdentifyChord(mustParseNotes("E G Bb D")...)
+
+		var rootless ChordCandidate
+		for _, c := range candidates {
+			if c.Rootless {
+				rootless = c
+				break
+			}
+		}
+		assert.Equal(t, "C9/E", rootless.Chord.Name)
+		assert.Equal(t, []Interval{{Quality: QualityPerfect, Number: 1}}, rootless.Missing)
+	})
+
+	t.Run("ignore repeated notes", func(t *testing.T) {
+		candidates := IdentifyChord(mustParseNotes("C G C E G C")...)
+		assert.Equal(t, "C", candidates[0].Chord.Name)
+	})
+
+	t.Run("return nothing for less than two different notes", func(t *testing.T) {
+		assert.Empty(t, IdentifyChord(mustParseNotes("C C")...))
+		assert.Empty(t, IdentifyChord())
+	})
+}
+
+func TestFretboard_IdentifyChord(t *testing.T) {
+	tests := []struct {
+		Shape        string
+		ExpectedName string
+	}{
+		{Shape: "x32010", ExpectedName: "C"},
+		{Shape: "320003", ExpectedName: "G"},
+		{Shape: "x02210", ExpectedName: "Amin"},
+		{Shape: "022100", ExpectedName: "E"},
+		{Shape: "x-x-0-2-1-2", ExpectedName: "D7"},
+		{Shape: "3x2010", ExpectedName: "C/G"},
+		{Shape: "x-3-5-5-4-3", ExpectedName: "Cmin"},
+		{Shape: "x-10-12-12-12-x", ExpectedName: "G"},
+	}
+
+	fb, _ := New(Options{})
+	for _, tt := range tests {
+		t.Run(tt.Shape, func(t *testing.T) {
+			positions, err := fb.ParseShape(tt.Shape)
+			assert.NoError(t, err)
+
+			candidates, err := fb.IdentifyChord(positions...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ExpectedName, candidates[0].Chord.Name)
+		})
+	}
+}
+
+func TestFretboard_ParseShape(t *testing.T) {
+	fb, _ := New(Options{Frets: 12})
+
+	t.Run("skip muted strings", func(t *testing.T) {
+		positions, err := fb.ParseShape("x32010")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Position{
+			{String: 5, Fret: 3},
+			{String: 4, Fret: 2},
+			{String: 3, Fret: 0},
+			{String: 2, Fret: 1},
+			{String: 1, Fret: 0},
+		}, positions)
+	})
+
+	t.Run("return error for a shape with the wrong number of strings", func(t *testing.T) {
+		_, err := fb.ParseShape("x3201")
+		assert.EqualError(t, err, "shape x3201 has 5 strings, but the tuning has 6")
+	})
+
+	t.Run("return error for an invalid fret", func(t *testing.T) {
+		_, err := fb.ParseShape("x-3-2-0-1-15")
+		assert.EqualError(t, err, `shape x-3-2-0-1-15 has an invalid fret "15"`)
+	})
+}
+
+func mustParseNotes(notes string) []Note {
+	fields := strings.Fields(notes)
+	result := make([]Note, len(fields))
+	for i, n := range fields {
+		result[i] = mustParseNote(n)
+	}
+	return result
+}
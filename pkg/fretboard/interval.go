@@ -0,0 +1,161 @@
+package fretboard
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type IntervalQuality int
+
+const (
+	QualityPerfect IntervalQuality = iota
+	QualityMajor
+	QualityMinor
+	QualityAugmented
+	QualityDiminished
+	QualityDoublyAugmented
+	QualityDoublyDiminished
+)
+
+var qualityAbbreviations = map[IntervalQuality]string{
+	QualityPerfect:          "P",
+	QualityMajor:            "M",
+	QualityMinor:            "m",
+	QualityAugmented:        "A",
+	QualityDiminished:       "d",
+	QualityDoublyAugmented:  "AA",
+	QualityDoublyDiminished: "dd",
+}
+
+type Interval struct {
+	Quality IntervalQuality
+	Number  int
+}
+
+func NewInterval(quality IntervalQuality, number int) (Interval, error) {
+	if number < 1 {
+		return Interval{}, fmt.Errorf("interval number %d must be at least 1", number)
+	}
+
+	i := Interval{Quality: quality, Number: number}
+	switch quality {
+	case QualityPerfect:
+		if !isPerfectNumber(number) {
+			return Interval{}, fmt.Errorf("interval %d can't be perfect", number)
+		}
+	case QualityMajor, QualityMinor:
+		if isPerfectNumber(number) {
+			return Interval{}, fmt.Errorf("interval %d can't be major or minor", number)
+		}
+	case QualityAugmented, QualityDiminished, QualityDoublyAugmented, QualityDoublyDiminished:
+	default:
+		return Interval{}, fmt.Errorf("unknown interval quality %d", quality)
+	}
+
+	return i, nil
+}
+
+func (i Interval) Semitones() int {
+	return i.degree().semitones()
+}
+
+func (i Interval) IsCompound() bool {
+	return i.Number > 8
+}
+
+func (i Interval) Simple() Interval {
+	if i.Number <= 8 {
+		return i
+	}
+
+	return Interval{Quality: i.Quality, Number: (i.Number-1)%7 + 1}
+}
+
+// Name returns the interval in quality-number notation, e.g. "m3", "A4" or "M9".
+func (i Interval) Name() string {
+	if i.Number == 0 {
+		return ""
+	}
+
+	return qualityAbbreviations[i.Quality] + strconv.Itoa(i.Number)
+}
+
+// String returns the interval relative to the major scale, e.g. "b3", "#4" or "9", which is
+// how intervals are usually labelled on a fretboard.
+func (i Interval) String() string {
+	if i.Number == 0 {
+		return ""
+	}
+
+	return i.degree().String()
+}
+
+func (i Interval) degree() degree {
+	d := degree{number: i.Number}
+	perfect := isPerfectNumber(i.Number)
+
+	switch i.Quality {
+	case QualityMinor:
+		d.alteration = -1
+	case QualityAugmented:
+		d.alteration = 1
+	case QualityDoublyAugmented:
+		d.alteration = 2
+	case QualityDiminished:
+		d.alteration = -2
+		if perfect {
+			d.alteration = -1
+		}
+	case QualityDoublyDiminished:
+		d.alteration = -3
+		if perfect {
+			d.alteration = -2
+		}
+	}
+
+	return d
+}
+
+func (d degree) interval() Interval {
+	i := Interval{Number: d.number}
+	if isPerfectNumber(d.number) {
+		switch {
+		case d.alteration == 0:
+			i.Quality = QualityPerfect
+		case d.alteration == 1:
+			i.Quality = QualityAugmented
+		case d.alteration == -1:
+			i.Quality = QualityDiminished
+		case d.alteration > 1:
+			i.Quality = QualityDoublyAugmented
+		default:
+			i.Quality = QualityDoublyDiminished
+		}
+		return i
+	}
+
+	switch {
+	case d.alteration == 0:
+		i.Quality = QualityMajor
+	case d.alteration == -1:
+		i.Quality = QualityMinor
+	case d.alteration == 1:
+		i.Quality = QualityAugmented
+	case d.alteration == -2:
+		i.Quality = QualityDiminished
+	case d.alteration > 1:
+		i.Quality = QualityDoublyAugmented
+	default:
+		i.Quality = QualityDoublyDiminished
+	}
+	return i
+}
+
+func isPerfectNumber(number int) bool {
+	switch (number - 1) % 7 {
+	case 0, 3, 4:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,94 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewInterval(t *testing.T) {
+	t.Run("return interval for a valid quality and number", func(t *testing.T) {
+		i, err := NewInterval(QualityAugmented, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Interval{Quality: QualityAugmented, Number: 4}, i)
+	})
+
+	t.Run("return error for an invalid combination", func(t *testing.T) {
+		tests := []struct {
+			Name    string
+			Quality IntervalQuality
+			Number  int
+		}{
+			{Name: "perfect third", Quality: QualityPerfect, Number: 3},
+			{Name: "major fifth", Quality: QualityMajor, Number: 5},
+			{Name: "minor eleventh", Quality: QualityMinor, Number: 11},
+			{Name: "number zero", Quality: QualityPerfect, Number: 0},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := NewInterval(tt.Quality, tt.Number)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestInterval(t *testing.T) {
+	tests := []struct {
+		Interval           Interval
+		ExpectedName       string
+		ExpectedString     string
+		ExpectedSemitones  int
+		ExpectedIsCompound bool
+	}{
+		{Interval: Interval{Quality: QualityPerfect, Number: 1}, ExpectedName: "P1", ExpectedString: "1", ExpectedSemitones: 0},
+		{Interval: Interval{Quality: QualityMinor, Number: 3}, ExpectedName: "m3", ExpectedString: "b3", ExpectedSemitones: 3},
+		{Interval: Interval{Quality: QualityAugmented, Number: 4}, ExpectedName: "A4", ExpectedString: "#4", ExpectedSemitones: 6},
+		{Interval: Interval{Quality: QualityDiminished, Number: 5}, ExpectedName: "d5", ExpectedString: "b5", ExpectedSemitones: 6},
+		{Interval: Interval{Quality: QualityDiminished, Number: 7}, ExpectedName: "d7", ExpectedString: "bb7", ExpectedSemitones: 9},
+		{Interval: Interval{Quality: QualityPerfect, Number: 8}, ExpectedName: "P8", ExpectedString: "8", ExpectedSemitones: 12},
+		{Interval: Interval{Quality: QualityMinor, Number: 9}, ExpectedName: "m9", ExpectedString: "b9", ExpectedSemitones: 13, ExpectedIsCompound: true},
+		{Interval: Interval{Quality: QualityAugmented, Number: 9}, ExpectedName: "A9", ExpectedString: "#9", ExpectedSemitones: 15, ExpectedIsCompound: true},
+		{Interval: Interval{Quality: QualityAugmented, Number: 11}, ExpectedName: "A11", ExpectedString: "#11", ExpectedSemitones: 18, ExpectedIsCompound: true},
+		{Interval: Interval{Quality: QualityMajor, Number: 13}, ExpectedName: "M13", ExpectedString: "13", ExpectedSemitones: 21, ExpectedIsCompound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ExpectedName, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedName, tt.Interval.Name())
+			assert.Equal(t, tt.ExpectedString, tt.Interval.String())
+			assert.Equal(t, tt.ExpectedSemitones, tt.Interval.Semitones())
+			assert.Equal(t, tt.ExpectedIsCompound, tt.Interval.IsCompound())
+		})
+	}
+}
+
+func TestInterval_Simple(t *testing.T) {
+	assert.Equal(t, Interval{Quality: QualityAugmented, Number: 2}, Interval{Quality: QualityAugmented, Number: 9}.Simple())
+	assert.Equal(t, Interval{Quality: QualityMajor, Number: 6}, Interval{Quality: QualityMajor, Number: 13}.Simple())
+	assert.Equal(t, Interval{Quality: QualityPerfect, Number: 8}, Interval{Quality: QualityPerfect, Number: 8}.Simple())
+}
+
+func TestPitch_IntervalTo(t *testing.T) {
+	tests := []struct {
+		From             string
+		To               string
+		ExpectedInterval string
+	}{
+		{From: "C4", To: "E4", ExpectedInterval: "M3"},
+		{From: "C4", To: "D5", ExpectedInterval: "M9"},
+		{From: "C4", To: "F#5", ExpectedInterval: "A11"},
+		{From: "C4", To: "Ab5", ExpectedInterval: "m13"},
+		{From: "E4", To: "C4", ExpectedInterval: "M3"},
+		{From: "B3", To: "C4", ExpectedInterval: "m2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.From+"-"+tt.To, func(t *testing.T) {
+			from, _ := NewPitch(tt.From)
+			to, _ := NewPitch(tt.To)
+			assert.Equal(t, tt.ExpectedInterval, from.IntervalTo(to).Name())
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package fretboard
+
+import (
+	"strings"
+)
+
+var (
+	letters          = "CDEFGAB"
+	naturalSemitones = []int{0, 2, 4, 5, 7, 9, 11}
+	sharpNotes       = []Note{{'C', 0}, {'C', 1}, {'D', 0}, {'D', 1}, {'E', 0}, {'F', 0}, {'F', 1}, {'G', 0}, {'G', 1}, {'A', 0}, {'A', 1}, {'B', 0}}
+	flatNotes        = []Note{{'C', 0}, {'D', -1}, {'D', 0}, {'E', -1}, {'E', 0}, {'F', 0}, {'G', -1}, {'G', 0}, {'A', -1}, {'A', 0}, {'B', -1}, {'B', 0}}
+)
+
+type Note struct {
+	letter     byte
+	accidental int
+}
+
+func NewNote(value string) (Note, error) {
+	return ParseNote(value, NoteParseOptions{})
+}
+
+func (n Note) Equals(other Note) bool {
+	if n.IsZero() || other.IsZero() {
+		return n == other
+	}
+
+	return n.pitchClass() == other.pitchClass()
+}
+
+func (n Note) IsZero() bool {
+	return n.letter == 0
+}
+
+func (n Note) Add(semitones uint) Note {
+	if semitones == 0 || semitones%12 == 0 {
+		return n
+	}
+
+	return sharpNotes[(n.pitchClass()+int(semitones%12))%12]
+}
+
+func (n Note) IntervalTo(other Note) Interval {
+	if n.IsZero() || other.IsZero() {
+		return Interval{}
+	}
+
+	number := (other.letterIndex()-n.letterIndex()+7)%7 + 1
+	semitones := int(n.semitonesTo(other))
+	alteration := ((semitones-majorScaleSemitones[number-1])%12+18)%12 - 6
+
+	return degree{number: number, alteration: alteration}.interval()
+}
+
+func (n Note) String() string {
+	if n.IsZero() {
+		return ""
+	}
+
+	switch {
+	case n.accidental > 0:
+		return string(n.letter) + strings.Repeat("#", n.accidental)
+	case n.accidental < 0:
+		return string(n.letter) + strings.Repeat("b", -n.accidental)
+	default:
+		return string(n.letter)
+	}
+}
+
+func (n Note) semitonesTo(other Note) uint {
+	return uint((other.pitchClass() - n.pitchClass() + 12) % 12)
+}
+
+func (n Note) pitchClass() int {
+	return (naturalSemitones[n.letterIndex()] + n.accidental + 12) % 12
+}
+
+func (n Note) letterIndex() int {
+	return strings.IndexByte(letters, n.letter)
+}
+
+// atDegree spells the note at the given degree above n, so that the degree number decides the
+// letter and only the accidental is adjusted to reach the right pitch. Spellings that would need
+// more than two accidentals fall back to the plain sharp or flat name of the pitch.
+func (n Note) atDegree(d degree) Note {
+	letterIndex := (n.letterIndex() + d.number - 1) % 7
+	pitchClass := (n.pitchClass() + d.semitones()) % 12
+
+	accidental := ((pitchClass-naturalSemitones[letterIndex])%12+18)%12 - 6
+	if accidental < -2 {
+		return flatNotes[pitchClass]
+	}
+	if accidental > 2 {
+		return sharpNotes[pitchClass]
+	}
+
+	return Note{letter: letters[letterIndex], accidental: accidental}
+}
+
+// rootBelow is the inverse of atDegree, it returns the note that has n at the given degree.
+func (n Note) rootBelow(d degree) Note {
+	letterIndex := ((n.letterIndex()-d.number+1)%7 + 7) % 7
+	pitchClass := ((n.pitchClass()-d.semitones())%12 + 12) % 12
+
+	accidental := ((pitchClass-naturalSemitones[letterIndex])%12+18)%12 - 6
+	if accidental < -2 {
+		return flatNotes[pitchClass]
+	}
+	if accidental > 2 {
+		return sharpNotes[pitchClass]
+	}
+
+	return Note{letter: letters[letterIndex], accidental: accidental}
+}
@@ -0,0 +1,150 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewNote(t *testing.T) {
+	t.Run("return note struct with correct value", func(t *testing.T) {
+		n, err := NewNote("A")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "A", n.String())
+	})
+
+	t.Run("return note struct for notes with accidentals", func(t *testing.T) {
+		for _, value := range []string{"Bb", "C#", "F##", "Ebb"} {
+			n, err := NewNote(value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, value, n.String())
+		}
+	})
+
+	t.Run("return error when note does not exist", func(t *testing.T) {
+		_, err := NewNote("M")
+		assert.Error(t, err)
+	})
+
+	t.Run("return error for an unknown accidental", func(t *testing.T) {
+		_, err := NewNote("C#b")
+		assert.Error(t, err)
+	})
+}
+
+func TestNote_Equals(t *testing.T) {
+	t.Run("return true when both notes have the same value", func(t *testing.T) {
+		a, b := mustParseNote("C"), mustParseNote("C")
+
+		assert.True(t, a.Equals(b))
+	})
+
+	t.Run("return true for enharmonic notes", func(t *testing.T) {
+		assert.True(t, mustParseNote("A#").Equals(mustParseNote("Bb")))
+		assert.True(t, mustParseNote("B#").Equals(mustParseNote("C")))
+		assert.True(t, mustParseNote("Fb").Equals(mustParseNote("E")))
+	})
+
+	t.Run("return false for the zero value", func(t *testing.T) {
+		assert.False(t, Note{}.Equals(mustParseNote("C")))
+	})
+
+	t.Run("return false when notes have different values", func(t *testing.T) {
+		a, b := mustParseNote("C"), mustParseNote("C#")
+
+		assert.False(t, a.Equals(b))
+	})
+}
+
+func TestNote_Add(t *testing.T) {
+	tests := []struct {
+		Name         string
+		StartNote    string
+		Halfsteps    uint
+		ExpectedNote string
+	}{
+		{Name: "Add 0 semitones from A", StartNote: "A", Halfsteps: 0, ExpectedNote: "A"},
+		{Name: "Add 1 semitone from A", StartNote: "A", Halfsteps: 1, ExpectedNote: "A#"},
+		{Name: "Add 2 semitones from A", StartNote: "A", Halfsteps: 2, ExpectedNote: "B"},
+		{Name: "Add 12 semitones from A", StartNote: "A", Halfsteps: 12, ExpectedNote: "A"},
+		{Name: "Add 13 semitones from A", StartNote: "A", Halfsteps: 13, ExpectedNote: "A#"},
+		{Name: "Add 1 semitone from E", StartNote: "E", Halfsteps: 1, ExpectedNote: "F"},
+		{Name: "Add 5 semitones from E", StartNote: "E", Halfsteps: 5, ExpectedNote: "A"},
+		{Name: "Add 22 semitones from E", StartNote: "E", Halfsteps: 22, ExpectedNote: "D"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			start := mustParseNote(tt.StartNote)
+			result := start.Add(tt.Halfsteps)
+
+			assert.Equal(t, tt.ExpectedNote, result.String())
+		})
+	}
+}
+
+func TestNote_IntervalTo(t *testing.T) {
+	tests := []struct {
+		Name             string
+		FirstNoteValue   string
+		SecondNoteValue  string
+		ExpectedInterval string
+	}{
+		{Name: "perfect unison for equal notes", FirstNoteValue: "G", SecondNoteValue: "G", ExpectedInterval: "1"},
+		{Name: "minor second for a single semitone", FirstNoteValue: "G", SecondNoteValue: "Ab", ExpectedInterval: "b2"},
+		{Name: "augmented unison for a single semitone", FirstNoteValue: "G", SecondNoteValue: "G#", ExpectedInterval: "#1"},
+		{Name: "major second for two semitones", FirstNoteValue: "G", SecondNoteValue: "A", ExpectedInterval: "2"},
+		{Name: "minor third for three semitones", FirstNoteValue: "G", SecondNoteValue: "Bb", ExpectedInterval: "b3"},
+		{Name: "augmented second for three semitones", FirstNoteValue: "G", SecondNoteValue: "A#", ExpectedInterval: "#2"},
+		{Name: "major third for four semitones", FirstNoteValue: "G", SecondNoteValue: "B", ExpectedInterval: "3"},
+		{Name: "perfect fourth for five semitones", FirstNoteValue: "G", SecondNoteValue: "C", ExpectedInterval: "4"},
+		{Name: "augmented fourth for six semitones", FirstNoteValue: "G", SecondNoteValue: "C#", ExpectedInterval: "#4"},
+		{Name: "diminished fifth for six semitones", FirstNoteValue: "G", SecondNoteValue: "Db", ExpectedInterval: "b5"},
+		{Name: "perfect fifth for seven semitones", FirstNoteValue: "G", SecondNoteValue: "D", ExpectedInterval: "5"},
+		{Name: "minor sixth for eight semitones", FirstNoteValue: "G", SecondNoteValue: "Eb", ExpectedInterval: "b6"},
+		{Name: "augmented fifth for eight semitones", FirstNoteValue: "G", SecondNoteValue: "D#", ExpectedInterval: "#5"},
+		{Name: "major sixth for nine semitones", FirstNoteValue: "G", SecondNoteValue: "E", ExpectedInterval: "6"},
+		{Name: "diminished seventh for nine semitones", FirstNoteValue: "G#", SecondNoteValue: "F", ExpectedInterval: "bb7"},
+		{Name: "minor seventh for ten semitones", FirstNoteValue: "G", SecondNoteValue: "F", ExpectedInterval: "b7"},
+		{Name: "major seventh for eleven semitones", FirstNoteValue: "G", SecondNoteValue: "F#", ExpectedInterval: "7"},
+		{Name: "augmented seventh across the octave", FirstNoteValue: "C", SecondNoteValue: "B#", ExpectedInterval: "#7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			n1, n2 := mustParseNote(tt.FirstNoteValue), mustParseNote(tt.SecondNoteValue)
+			assert.Equal(t, tt.ExpectedInterval, n1.IntervalTo(n2).String())
+		})
+	}
+}
+
+func TestNote_atDegree(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Root         string
+		Degree       degree
+		ExpectedNote string
+	}{
+		{Name: "minor third of C", Root: "C", Degree: degree{number: 3, alteration: -1}, ExpectedNote: "Eb"},
+		{Name: "augmented second of C", Root: "C", Degree: degree{number: 2, alteration: 1}, ExpectedNote: "D#"},
+		{Name: "major seventh of G#", Root: "G#", Degree: degree{number: 7}, ExpectedNote: "F##"},
+		{Name: "diminished seventh of C", Root: "C", Degree: degree{number: 7, alteration: -2}, ExpectedNote: "Bbb"},
+		{Name: "ninth of Bb", Root: "Bb", Degree: degree{number: 9}, ExpectedNote: "C"},
+		{Name: "fallback for more than two accidentals", Root: "Cb", Degree: degree{number: 7, alteration: -2}, ExpectedNote: "Ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedNote, mustParseNote(tt.Root).atDegree(tt.Degree).String())
+		})
+	}
+}
+
+func mustParseNote(value string) Note {
+	n, err := NewNote(value)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
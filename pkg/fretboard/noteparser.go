@@ -0,0 +1,163 @@
+package fretboard
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type NoteNaming uint
+
+const (
+	NamingEnglish NoteNaming = iota
+	NamingGerman
+	NamingDutch
+)
+
+// ParseNoteNaming accepts "english", "german" and "dutch", an empty naming being English.
+func ParseNoteNaming(naming string) (NoteNaming, error) {
+	switch strings.ToLower(strings.TrimSpace(naming)) {
+	case "english", "":
+		return NamingEnglish, nil
+	case "german":
+		return NamingGerman, nil
+	case "dutch":
+		return NamingDutch, nil
+	default:
+		return 0, fmt.Errorf("naming %s must be english, german or dutch", naming)
+	}
+}
+
+var (
+	commonAccidentals = []accidentalToken{
+		{token: "𝄪", accidental: 2},
+		{token: "𝄫", accidental: -2},
+		{token: "♯", accidental: 1},
+		{token: "♭", accidental: -1},
+		{token: "♮", accidental: 0},
+		{token: "#", accidental: 1},
+		{token: "x", accidental: 2},
+		{token: "b", accidental: -1},
+	}
+	englishAccidentals = []accidentalToken{
+		{token: "sharp", accidental: 1},
+		{token: "flat", accidental: -1},
+	}
+	germanAccidentals = []accidentalToken{
+		{token: "isis", accidental: 2},
+		{token: "eses", accidental: -2},
+		{token: "is", accidental: 1},
+		{token: "es", accidental: -1},
+		{token: "s", accidental: -1},
+	}
+)
+
+type NoteParseOptions struct {
+	Strict bool
+	Naming NoteNaming
+}
+
+type NoteParseError struct {
+	Input      string
+	Understood string
+	Remainder  string
+	Reason     string
+}
+
+func (e *NoteParseError) Error() string {
+	if e.Understood == "" {
+		return fmt.Sprintf("note does not exist: %s (%s)", e.Input, e.Reason)
+	}
+
+	return fmt.Sprintf("note does not exist: %s (understood %s, %s: %q)", e.Input, e.Understood, e.Reason, e.Remainder)
+}
+
+type accidentalToken struct {
+	token      string
+	accidental int
+}
+
+func ParseNote(value string, options NoteParseOptions) (Note, error) {
+	if options.Strict {
+		return parseStrictNote(value)
+	}
+
+	input := strings.TrimSpace(value)
+	r, size := utf8.DecodeRuneInString(input)
+	letter := unicode.ToUpper(r)
+
+	var n Note
+	switch {
+	case letter >= 'A' && letter <= 'G':
+		n.letter = byte(letter)
+	case letter == 'H' && options.Naming == NamingGerman:
+		n.letter = 'B'
+	default:
+		return Note{}, &NoteParseError{Input: value, Remainder: input, Reason: "expected a note name starting with a letter from A to G"}
+	}
+	if letter == 'B' && options.Naming == NamingGerman {
+		n.accidental = -1
+	}
+
+	rest := strings.ToLower(input[size:])
+	hasSharps, hasFlats := false, false
+	for rest != "" {
+		t, ok := matchAccidental(strings.TrimLeft(rest, " -"), options.Naming)
+		if !ok {
+			return Note{}, &NoteParseError{Input: value, Understood: n.String(), Remainder: rest, Reason: "unknown accidental"}
+		}
+
+		hasSharps = hasSharps || t.accidental > 0
+		hasFlats = hasFlats || t.accidental < 0
+		if hasSharps && hasFlats {
+			return Note{}, &NoteParseError{Input: value, Understood: n.String(), Remainder: rest, Reason: "sharps and flats can't be mixed"}
+		}
+		if n.accidental+t.accidental > 2 || n.accidental+t.accidental < -2 {
+			return Note{}, &NoteParseError{Input: value, Understood: n.String(), Remainder: rest, Reason: "more than two accidentals"}
+		}
+
+		n.accidental += t.accidental
+		rest = strings.TrimPrefix(strings.TrimLeft(rest, " -"), t.token)
+	}
+
+	return n, nil
+}
+
+func parseStrictNote(value string) (Note, error) {
+	if len(value) == 0 || strings.IndexByte(letters, value[0]) < 0 {
+		return Note{}, &NoteParseError{Input: value, Remainder: value, Reason: "expected an upper case letter from A to G"}
+	}
+
+	n := Note{letter: value[0]}
+	switch value[1:] {
+	case "":
+	case "#":
+		n.accidental = 1
+	case "##":
+		n.accidental = 2
+	case "b":
+		n.accidental = -1
+	case "bb":
+		n.accidental = -2
+	default:
+		return Note{}, &NoteParseError{Input: value, Understood: n.String(), Remainder: value[1:], Reason: "expected #, ##, b or bb"}
+	}
+
+	return n, nil
+}
+
+func matchAccidental(s string, naming NoteNaming) (accidentalToken, bool) {
+	tokens := englishAccidentals
+	if naming == NamingGerman || naming == NamingDutch {
+		tokens = germanAccidentals
+	}
+
+	for _, t := range append(append([]accidentalToken{}, tokens...), commonAccidentals...) {
+		if strings.HasPrefix(s, t.token) {
+			return t, true
+		}
+	}
+
+	return accidentalToken{}, false
+}
@@ -0,0 +1,136 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseNote(t *testing.T) {
+	t.Run("normalise case, ASCII and Unicode accidentals", func(t *testing.T) {
+		tests := []struct {
+			Input        string
+			ExpectedNote string
+		}{
+			{Input: "a", ExpectedNote: "A"},
+			{Input: " Bb ", ExpectedNote: "Bb"},
+			{Input: "bb", ExpectedNote: "Bb"},
+			{Input: "B♭", ExpectedNote: "Bb"},
+			{Input: "C♯", ExpectedNote: "C#"},
+			{Input: "F𝄪", ExpectedNote: "F##"},
+			{Input: "E𝄫", ExpectedNote: "Ebb"},
+			{Input: "Cx", ExpectedNote: "C##"},
+			{Input: "D♮", ExpectedNote: "D"},
+			{Input: "F sharp", ExpectedNote: "F#"},
+			{Input: "e-flat", ExpectedNote: "Eb"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Input, func(t *testing.T) {
+				n, err := ParseNote(tt.Input, NoteParseOptions{})
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedNote, n.String())
+			})
+		}
+	})
+
+	t.Run("parse German and Dutch note names", func(t *testing.T) {
+		tests := []struct {
+			Input        string
+			Naming       NoteNaming
+			ExpectedNote string
+		}{
+			{Input: "Cis", Naming: NamingGerman, ExpectedNote: "C#"},
+			{Input: "Fisis", Naming: NamingGerman, ExpectedNote: "F##"},
+			{Input: "Es", Naming: NamingGerman, ExpectedNote: "Eb"},
+			{Input: "As", Naming: NamingGerman, ExpectedNote: "Ab"},
+			{Input: "Des", Naming: NamingGerman, ExpectedNote: "Db"},
+			{Input: "H", Naming: NamingGerman, ExpectedNote: "B"},
+			{Input: "B", Naming: NamingGerman, ExpectedNote: "Bb"},
+			{Input: "Heses", Naming: NamingGerman, ExpectedNote: "Bbb"},
+			{Input: "Bes", Naming: NamingDutch, ExpectedNote: "Bb"},
+			{Input: "B", Naming: NamingDutch, ExpectedNote: "B"},
+			{Input: "gis", Naming: NamingDutch, ExpectedNote: "G#"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Input, func(t *testing.T) {
+				n, err := ParseNote(tt.Input, NoteParseOptions{Naming: tt.Naming})
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedNote, n.String())
+			})
+		}
+	})
+
+	t.Run("reject German suffixes and H in English naming", func(t *testing.T) {
+		for _, input := range []string{"Cis", "H"} {
+			_, err := ParseNote(input, NoteParseOptions{})
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("accept only exact ASCII names in strict mode", func(t *testing.T) {
+		for _, input := range []string{"A", "Bb", "C#", "F##", "Ebb"} {
+			n, err := ParseNote(input, NoteParseOptions{Strict: true})
+
+			assert.NoError(t, err)
+			assert.Equal(t, input, n.String())
+		}
+
+		for _, input := range []string{"a", "B♭", " C", "Cx"} {
+			_, err := ParseNote(input, NoteParseOptions{Strict: true})
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("return error listing what was understood", func(t *testing.T) {
+		tests := []struct {
+			Input         string
+			ExpectedError string
+		}{
+			{Input: "", ExpectedError: "note does not exist:  (expected a note name starting with a letter from A to G)"},
+			{Input: "M", ExpectedError: "note does not exist: M (expected a note name starting with a letter from A to G)"},
+			{Input: "C#?", ExpectedError: `note does not exist: C#? (understood C#, unknown accidental: "?")`},
+			{Input: "C#b", ExpectedError: `note does not exist: C#b (understood C#, sharps and flats can't be mixed: "b")`},
+			{Input: "Cx#", ExpectedError: `note does not exist: Cx# (understood C##, more than two accidentals: "#")`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Input, func(t *testing.T) {
+				_, err := ParseNote(tt.Input, NoteParseOptions{})
+
+				var parseErr *NoteParseError
+				assert.ErrorAs(t, err, &parseErr)
+				assert.EqualError(t, err, tt.ExpectedError)
+			})
+		}
+	})
+}
+
+func TestParseNoteNaming(t *testing.T) {
+	t.Run("accept the namings of notes", func(t *testing.T) {
+		tests := []struct {
+			Naming   string
+			Expected NoteNaming
+		}{
+			{Naming: "english", Expected: NamingEnglish},
+			{Naming: "", Expected: NamingEnglish},
+			{Naming: "German", Expected: NamingGerman},
+			{Naming: " dutch ", Expected: NamingDutch},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Naming, func(t *testing.T) {
+				naming, err := ParseNoteNaming(tt.Naming)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, naming)
+			})
+		}
+	})
+
+	t.Run("return error for an unknown naming", func(t *testing.T) {
+		_, err := ParseNoteNaming("french")
+		assert.EqualError(t, err, "naming french must be english, german or dutch")
+	})
+}
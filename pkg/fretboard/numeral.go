@@ -0,0 +1,130 @@
+package fretboard
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	romanNumerals      = []string{"I", "II", "III", "IV", "V", "VI", "VII"}
+	numeralAccidentals = []accidentalToken{
+		{token: "b", accidental: -1},
+		{token: "♭", accidental: -1},
+		{token: "#", accidental: 1},
+		{token: "♯", accidental: 1},
+	}
+)
+
+// romanNumeral names the chord by its root's degree in the major scale of tonic. Chords with a
+// minor third get a lower case numeral, the quality of the chord is added as a suffix, e.g.
+// "V7", "viiø7", "bVII" or "iii°".
+func romanNumeral(tonic Note, c Chord) string {
+	d := tonic.IntervalTo(c.Root).degree()
+
+	numeral := romanNumerals[(d.number-1)%7]
+	if c.hasDegree(degree{number: 3, alteration: -1}) {
+		numeral = strings.ToLower(numeral)
+	}
+
+	accidental := ""
+	if d.alteration < 0 {
+		accidental = strings.Repeat("b", -d.alteration)
+	} else if d.alteration > 0 {
+		accidental = strings.Repeat("#", d.alteration)
+	}
+
+	return accidental + numeral + numeralSuffix(strings.TrimPrefix(c.baseName(), c.Root.String()))
+}
+
+// numeralSuffix turns a chord suffix into the symbols used with Roman numerals, the minor
+// quality is already expressed by the lower case numeral.
+func numeralSuffix(suffix string) string {
+	switch {
+	case strings.HasPrefix(suffix, "minMaj"):
+		return "maj" + strings.TrimPrefix(suffix, "minMaj")
+	case strings.HasPrefix(suffix, "min") && strings.Contains(suffix, "b5"):
+		return "ø" + strings.Replace(strings.TrimPrefix(suffix, "min"), "b5", "", 1)
+	case strings.HasPrefix(suffix, "min"):
+		return strings.TrimPrefix(suffix, "min")
+	case strings.HasPrefix(suffix, "dimMaj"):
+		return "°maj" + strings.TrimPrefix(suffix, "dimMaj")
+	case strings.HasPrefix(suffix, "dim"):
+		return "°" + strings.TrimPrefix(suffix, "dim")
+	case strings.HasPrefix(suffix, "aug"):
+		return "+" + strings.TrimPrefix(suffix, "aug")
+	default:
+		return suffix
+	}
+}
+
+// numeralSymbol is a Roman numeral split into its parts, e.g. "bVII7" is the seventh degree
+// lowered by a semitone with a major quality and the suffix "7".
+type numeralSymbol struct {
+	degree  degree
+	minor   bool
+	quality string
+	suffix  string
+}
+
+func parseNumeral(token string) (numeralSymbol, error) {
+	var n numeralSymbol
+	rest := token
+	for matched := true; matched; {
+		matched = false
+		for _, a := range numeralAccidentals {
+			if strings.HasPrefix(rest, a.token) {
+				n.degree.alteration += a.accidental
+				rest = rest[len(a.token):]
+				matched = true
+			}
+		}
+	}
+
+	for i := len(romanNumerals) - 1; i >= 0; i-- {
+		upper, lower := romanNumerals[i], strings.ToLower(romanNumerals[i])
+		if strings.HasPrefix(rest, upper) || strings.HasPrefix(rest, lower) {
+			n.degree.number = i + 1
+			n.minor = strings.HasPrefix(rest, lower)
+			rest = rest[len(upper):]
+			break
+		}
+	}
+	if n.degree.number == 0 {
+		return numeralSymbol{}, fmt.Errorf("%s is not a Roman numeral", token)
+	}
+
+	for _, q := range []string{"°", "o", "ø", "Ø", "+"} {
+		if strings.HasPrefix(rest, q) {
+			n.quality = q
+			rest = rest[len(q):]
+			break
+		}
+	}
+	n.suffix = rest
+
+	return n, nil
+}
+
+// chordSymbol returns the chord symbol of the numeral on the given root, e.g. "Bdim7" for
+// "vii°7" on B.
+func (n numeralSymbol) chordSymbol(root Note) string {
+	quality := ""
+	switch {
+	case n.quality == "°" || n.quality == "o":
+		quality = "dim"
+	case n.quality == "ø" || n.quality == "Ø":
+		quality = "ø"
+	case n.quality == "+":
+		quality = "aug"
+	case n.minor:
+		quality = "m"
+	}
+
+	return root.String() + quality + n.suffix
+}
+
+// withoutQuality removes the quality symbols from a numeral, so "vii7" can match the diatonic
+// "viiø7" of a major key.
+func withoutQuality(numeral string) string {
+	return strings.NewReplacer("°", "", "ø", "", "+", "").Replace(numeral)
+}
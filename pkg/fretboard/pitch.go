@@ -0,0 +1,100 @@
+package fretboard
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const DefaultConcertPitch = 440.0
+
+type Pitch struct {
+	Note   Note
+	Octave int
+}
+
+func NewPitch(value string) (Pitch, error) {
+	return ParsePitch(value, NoteParseOptions{})
+}
+
+func ParsePitch(value string, options NoteParseOptions) (Pitch, error) {
+	input := strings.TrimSpace(value)
+	name := strings.TrimRight(input, "0123456789")
+	name = strings.TrimSuffix(name, "-")
+	if name == input {
+		return Pitch{}, fmt.Errorf("pitch %s has no octave", value)
+	}
+
+	octave, err := strconv.Atoi(input[len(name):])
+	if err != nil {
+		return Pitch{}, fmt.Errorf("pitch %s has an invalid octave: %w", value, err)
+	}
+
+	note, err := ParseNote(name, options)
+	if err != nil {
+		return Pitch{}, err
+	}
+
+	return Pitch{Note: note, Octave: octave}, nil
+}
+
+func PitchFromMIDI(number int) Pitch {
+	return pitchWithNote(number, sharpNotes[(number%12+12)%12])
+}
+
+func (p Pitch) MIDI() int {
+	return (p.Octave+1)*12 + naturalSemitones[p.Note.letterIndex()] + p.Note.accidental
+}
+
+func (p Pitch) Frequency(concertPitch float64) float64 {
+	return concertPitch * math.Pow(2, float64(p.MIDI()-69)/12)
+}
+
+func (p Pitch) Add(semitones uint) Pitch {
+	return PitchFromMIDI(p.MIDI() + int(semitones))
+}
+
+// Transpose moves the pitch up by the given number of semitones, or down for negative numbers.
+// The note is spelled like in Note.Transpose.
+func (p Pitch) Transpose(semitones int) Pitch {
+	return pitchWithNote(p.MIDI()+semitones, p.Note.Transpose(semitones))
+}
+
+func (p Pitch) IntervalTo(other Pitch) Interval {
+	if other.MIDI() < p.MIDI() {
+		return other.IntervalTo(p)
+	}
+
+	steps := (other.Octave*7 + other.Note.letterIndex()) - (p.Octave*7 + p.Note.letterIndex())
+	if steps < 0 {
+		steps = 0
+	}
+
+	d := degree{number: steps + 1}
+	d.alteration = other.MIDI() - p.MIDI() - d.semitones()
+	return d.interval()
+}
+
+func (p Pitch) Equals(other Pitch) bool {
+	return p.MIDI() == other.MIDI()
+}
+
+func (p Pitch) IsZero() bool {
+	return p.Note.IsZero()
+}
+
+func (p Pitch) String() string {
+	if p.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%s%d", p.Note, p.Octave)
+}
+
+// pitchWithNote spells the given MIDI note number with note, which has to be enharmonically
+// equal to it. The octave follows the letter, so B#3 and C4 share the same number.
+func pitchWithNote(number int, note Note) Pitch {
+	semitones := naturalSemitones[note.letterIndex()] + note.accidental
+	return Pitch{Note: note, Octave: int(math.Floor(float64(number-semitones)/12)) - 1}
+}
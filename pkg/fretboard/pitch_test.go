@@ -0,0 +1,88 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParsePitch(t *testing.T) {
+	t.Run("return pitch with note and octave", func(t *testing.T) {
+		tests := []struct {
+			Input          string
+			ExpectedNote   string
+			ExpectedOctave int
+		}{
+			{Input: "E2", ExpectedNote: "E", ExpectedOctave: 2},
+			{Input: "bb3", ExpectedNote: "Bb", ExpectedOctave: 3},
+			{Input: "C#10", ExpectedNote: "C#", ExpectedOctave: 10},
+			{Input: "C-1", ExpectedNote: "C", ExpectedOctave: -1},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Input, func(t *testing.T) {
+				p, err := NewPitch(tt.Input)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedNote, p.Note.String())
+				assert.Equal(t, tt.ExpectedOctave, p.Octave)
+			})
+		}
+	})
+
+	t.Run("return error for invalid pitches", func(t *testing.T) {
+		for _, input := range []string{"E", "M2", "", "4"} {
+			_, err := NewPitch(input)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestPitch_MIDI(t *testing.T) {
+	tests := []struct {
+		Pitch        string
+		ExpectedMIDI int
+	}{
+		{Pitch: "C-1", ExpectedMIDI: 0},
+		{Pitch: "C4", ExpectedMIDI: 60},
+		{Pitch: "A4", ExpectedMIDI: 69},
+		{Pitch: "E2", ExpectedMIDI: 40},
+		{Pitch: "B#3", ExpectedMIDI: 60},
+		{Pitch: "Cb4", ExpectedMIDI: 59},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Pitch, func(t *testing.T) {
+			p, _ := NewPitch(tt.Pitch)
+			assert.Equal(t, tt.ExpectedMIDI, p.MIDI())
+		})
+	}
+}
+
+func TestPitchFromMIDI(t *testing.T) {
+	assert.Equal(t, "C4", PitchFromMIDI(60).String())
+	assert.Equal(t, "A#0", PitchFromMIDI(22).String())
+	assert.Equal(t, "C-1", PitchFromMIDI(0).String())
+}
+
+func TestPitch_Frequency(t *testing.T) {
+	a4, _ := NewPitch("A4")
+	e2, _ := NewPitch("E2")
+
+	assert.InDelta(t, 440.0, a4.Frequency(DefaultConcertPitch), 0.001)
+	assert.InDelta(t, 432.0, a4.Frequency(432), 0.001)
+	assert.InDelta(t, 82.407, e2.Frequency(DefaultConcertPitch), 0.001)
+}
+
+func TestPitch_Add(t *testing.T) {
+	e2, _ := NewPitch("E2")
+
+	assert.Equal(t, "A2", e2.Add(5).String())
+	assert.Equal(t, "E3", e2.Add(12).String())
+	assert.Equal(t, "C3", e2.Add(8).String())
+}
+
+func TestPitchWithNote(t *testing.T) {
+	assert.Equal(t, "B#3", pitchWithNote(60, mustParseNote("B#")).String())
+	assert.Equal(t, "Cb4", pitchWithNote(59, mustParseNote("Cb")).String())
+	assert.Equal(t, "Bb3", pitchWithNote(58, mustParseNote("Bb")).String())
+}
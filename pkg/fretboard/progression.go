@@ -0,0 +1,280 @@
+package fretboard
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Progression is a series of chords in a key.
+type Progression struct {
+	Key    Scale
+	Chords []ProgressionChord
+}
+
+// ProgressionChord is a chord of a progression with its Roman numeral in the key. Secondary is
+// set for chords leading to another chord of the key, like V/V, Borrowed for chords taken from
+// outside of the key, like bVII in a major key.
+type ProgressionChord struct {
+	Chord
+	Numeral   string
+	Secondary bool
+	Borrowed  bool
+}
+
+// ParseProgression reads a progression of Roman numerals like "ii-V-I" or "I vi IV V7/V" in the
+// given key, or a progression of chord names like "Dm7 G7 Cmaj7" which is analysed in the key.
+// Every token is read as a numeral if possible and as a chord name otherwise, so chord names may
+// be written in lowercase like "am F C G" and mixed with numerals. The key of a progression of
+// chord names is detected if it is the zero Scale.
+func ParseProgression(key Scale, progression string) (Progression, error) {
+	tokens := progressionTokens(progression)
+	if len(tokens) == 0 {
+		return Progression{}, errors.New("progression must contain at least one chord")
+	}
+
+	_, err := parseNumeral(tokens[0])
+	numeralsFirst := err == nil
+
+	chords := make([]Chord, len(tokens))
+	var named []Chord
+	for i, token := range tokens {
+		if _, err := parseNumeral(token); err == nil {
+			continue
+		}
+
+		c, err := ParseChord(token)
+		if err != nil && numeralsFirst {
+			_, err = parseNumeral(token)
+			return Progression{}, fmt.Errorf("invalid numeral %s in progression: %w", token, err)
+		}
+		if err != nil {
+			return Progression{}, err
+		}
+		chords[i] = c
+		named = append(named, c)
+	}
+
+	if key.Root.IsZero() {
+		if len(named) < len(tokens) {
+			return Progression{}, errors.New("a progression of Roman numerals needs a key")
+		}
+		key = DetectKey(named...)
+	}
+
+	p := Progression{Key: key, Chords: make([]ProgressionChord, len(tokens))}
+	for i, token := range tokens {
+		if chords[i].Name != "" {
+			p.Chords[i] = key.analyzeChord(chords[i])
+			continue
+		}
+
+		c, err := key.resolveNumeral(token)
+		if err != nil {
+			return Progression{}, fmt.Errorf("invalid numeral %s in progression: %w", token, err)
+		}
+		p.Chords[i] = c
+	}
+
+	return p, nil
+}
+
+// AnalyzeProgression names the chords by their function in the key. Chords outside of the key
+// are secondary chords if they lead to a chord of the key and borrowed chords otherwise.
+func AnalyzeProgression(key Scale, chords ...Chord) Progression {
+	p := Progression{Key: key, Chords: make([]ProgressionChord, len(chords))}
+	for i, c := range chords {
+		p.Chords[i] = key.analyzeChord(c)
+	}
+	return p
+}
+
+// DetectKey returns the major or minor key most of the chords belong to, minor keys including
+// the dominant of the harmonic minor scale. Relative keys share their chords, so tonic chords
+// break ties, especially at the start and at the end of the progression.
+func DetectKey(chords ...Chord) Scale {
+	if len(chords) == 0 {
+		return Scale{}
+	}
+
+	var notes []Note
+	for _, c := range chords {
+		notes = append(notes, c.Notes()...)
+	}
+
+	var best Scale
+	bestScore := -1
+	for i := 0; i < 12; i++ {
+		pitchClass := (chords[0].Root.pitchClass() + i) % 12
+		for _, scaleType := range []string{ScaleMajor, ScaleMinor} {
+			definition, _ := DefaultScales.Lookup(scaleType)
+			key := spelledScale(definition, pitchClass, notes)
+
+			score := 0
+			for _, c := range chords {
+				if key.keyContains(c) {
+					score += 3
+				}
+				if key.isTonic(c) {
+					score++
+				}
+			}
+			if key.isTonic(chords[0]) {
+				score++
+			}
+			if key.isTonic(chords[len(chords)-1]) {
+				score += 2
+			}
+
+			if score > bestScore {
+				best, bestScore = key, score
+			}
+		}
+	}
+
+	return best
+}
+
+// progressionTokens splits a progression at whitespace, commas and bar lines. Numerals may also
+// be separated by dashes like "ii-V-I", chord names keep them for minor chords like "C-7".
+func progressionTokens(progression string) []string {
+	fields := strings.FieldsFunc(progression, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '|'
+	})
+
+	var tokens []string
+	for _, field := range fields {
+		if _, err := parseNumeral(field); err != nil {
+			tokens = append(tokens, field)
+			continue
+		}
+		tokens = append(tokens, strings.FieldsFunc(field, func(r rune) bool {
+			return r == '-' || r == '–'
+		})...)
+	}
+	return tokens
+}
+
+// resolveNumeral returns the chord of a numeral in the scale. Numerals matching a diatonic chord
+// use it, the quality symbols may be left out, so "vii" is the diminished chord of a major key.
+// Secondary numerals like "V7/ii" are resolved in the major or minor key of their target.
+func (s Scale) resolveNumeral(token string) (ProgressionChord, error) {
+	if i := strings.LastIndex(token, "/"); i >= 0 {
+		r, _ := utf8.DecodeRuneInString(token[i+1:])
+		if !unicode.IsDigit(r) {
+			return s.resolveSecondaryNumeral(token[:i], token[i+1:])
+		}
+	}
+
+	n, err := parseNumeral(token)
+	if err != nil {
+		return ProgressionChord{}, err
+	}
+
+	for size := ChordSizeTriad; size <= ChordSizeThirteenth; size++ {
+		chords, err := s.ChordsOfSize(size)
+		if err != nil {
+			return ProgressionChord{}, err
+		}
+		for _, c := range chords {
+			if c.Numeral == token || (n.quality == "" && withoutQuality(c.Numeral) == token) {
+				return ProgressionChord{Chord: c.Chord, Numeral: c.Numeral}, nil
+			}
+		}
+	}
+
+	c, err := ParseChord(n.chordSymbol(s.Root.atDegree(n.degree)))
+	if err != nil {
+		return ProgressionChord{}, err
+	}
+
+	return ProgressionChord{Chord: c, Numeral: token, Borrowed: !s.containsAll(c.Notes())}, nil
+}
+
+func (s Scale) resolveSecondaryNumeral(numeral string, target string) (ProgressionChord, error) {
+	t, err := s.resolveNumeral(target)
+	if err != nil {
+		return ProgressionChord{}, err
+	}
+	if t.hasDegree(degree{number: 5, alteration: -1}) {
+		return ProgressionChord{}, fmt.Errorf("the diminished chord %s can't be a target", target)
+	}
+
+	scaleType := ScaleMajor
+	if t.hasDegree(degree{number: 3, alteration: -1}) {
+		scaleType = ScaleMinor
+	}
+	definition, _ := DefaultScales.Lookup(scaleType)
+	key := newScaleFromDegrees(t.Root, definition.Name, definition.degrees)
+
+	c, err := key.resolveNumeral(numeral)
+	if err != nil {
+		return ProgressionChord{}, err
+	}
+
+	return ProgressionChord{Chord: c.Chord, Numeral: c.Numeral + "/" + t.Numeral, Secondary: true}, nil
+}
+
+func (s Scale) analyzeChord(c Chord) ProgressionChord {
+	if s.keyContains(c) {
+		return ProgressionChord{Chord: c, Numeral: romanNumeral(s.Root, c)}
+	}
+
+	if target, ok := s.secondaryTarget(c); ok {
+		return ProgressionChord{Chord: c, Numeral: romanNumeral(target.Root, c) + "/" + target.Numeral, Secondary: true}
+	}
+
+	return ProgressionChord{Chord: c, Numeral: romanNumeral(s.Root, c), Borrowed: true}
+}
+
+// secondaryTarget finds the chord of the scale that c leads to: major and dominant chords
+// resolve a fifth down, diminished chords a semitone up. The tonic can't be a target, a
+// dominant leading to it is just a V.
+func (s Scale) secondaryTarget(c Chord) (DiatonicChord, bool) {
+	var targetRoot Note
+	switch {
+	case c.hasDegree(degree{number: 3}) && c.hasDegree(degree{number: 5}) && !c.hasDegree(degree{number: 7}):
+		targetRoot = c.Root.atDegree(degree{number: 4})
+	case c.hasDegree(degree{number: 3, alteration: -1}) && c.hasDegree(degree{number: 5, alteration: -1}):
+		targetRoot = c.Root.atDegree(degree{number: 2, alteration: -1})
+	default:
+		return DiatonicChord{}, false
+	}
+
+	triads, err := s.ChordsOfSize(ChordSizeTriad)
+	if err != nil {
+		return DiatonicChord{}, false
+	}
+	for _, t := range triads {
+		if t.Degree != 1 && t.Root.Equals(targetRoot) && !t.hasDegree(degree{number: 5, alteration: -1}) {
+			return t, true
+		}
+	}
+	return DiatonicChord{}, false
+}
+
+// keyContains reports whether all notes of the chord belong to the key. Minor keys also contain
+// the dominant of the harmonic minor scale, like E7 in A minor.
+func (s Scale) keyContains(c Chord) bool {
+	if s.containsAll(c.Notes()) {
+		return true
+	}
+	if s.scaleType != ScaleMinor || !c.Root.Equals(s.Root.atDegree(degree{number: 5})) {
+		return false
+	}
+
+	definition, _ := DefaultScales.Lookup(ScaleHarmonicMinor)
+	return newScaleFromDegrees(s.Root, definition.Name, definition.degrees).containsAll(c.Notes())
+}
+
+// isTonic reports whether the chord is built on the tonic triad of the key, e.g. Am7 in A minor
+// but not A7.
+func (s Scale) isTonic(c Chord) bool {
+	third := degree{number: 3}
+	if s.scaleType == ScaleMinor {
+		third.alteration = -1
+	}
+	return c.Root.Equals(s.Root) && c.hasDegree(third) && c.hasDegree(degree{number: 5})
+}
@@ -0,0 +1,179 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseProgression(t *testing.T) {
+	cMajor, _ := NewScale("C", ScaleMajor)
+	aMinor, _ := NewScale("A", ScaleMinor)
+
+	t.Run("resolve Roman numerals in a key", func(t *testing.T) {
+		tests := []struct {
+			Progression      string
+			Key              Scale
+			ExpectedChords   []string
+			ExpectedNumerals []string
+		}{
+			{Progression: "ii-V-I", Key: cMajor, ExpectedChords: []string{"Dmin", "G", "C"}, ExpectedNumerals: []string{"ii", "V", "I"}},
+			{Progression: "I vi IV V", Key: cMajor, ExpectedChords: []string{"C", "Amin", "F", "G"}, ExpectedNumerals: []string{"I", "vi", "IV", "V"}},
+			{Progression: "ii7 | V7 | Imaj7", Key: cMajor, ExpectedChords: []string{"Dmin7", "G7", "Cmaj7"}, ExpectedNumerals: []string{"ii7", "V7", "Imaj7"}},
+			{Progression: "vii vii7", Key: cMajor, ExpectedChords: []string{"Bdim", "Bmin7b5"}, ExpectedNumerals: []string{"vii°", "viiø7"}},
+			{Progression: "I6/9 IVadd9", Key: cMajor, ExpectedChords: []string{"C6/9", "Fadd9"}, ExpectedNumerals: []string{"I6/9", "IVadd9"}},
+			{Progression: "i bVI bIII bVII", Key: aMinor, ExpectedChords: []string{"Amin", "F", "C", "G"}, ExpectedNumerals: []string{"i", "bVI", "bIII", "bVII"}},
+			{Progression: "i iv V7 i", Key: aMinor, ExpectedChords: []string{"Amin", "Dmin", "E7", "Amin"}, ExpectedNumerals: []string{"i", "iv", "V7", "i"}},
+			{Progression: "C vi F V", Key: cMajor, ExpectedChords: []string{"C", "Amin", "F", "G"}, ExpectedNumerals: []string{"I", "vi", "IV", "V"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Progression, func(t *testing.T) {
+				p, err := ParseProgression(tt.Key, tt.Progression)
+				assert.NoError(t, err)
+
+				chords, numerals := make([]string, len(p.Chords)), make([]string, len(p.Chords))
+				for i, c := range p.Chords {
+					chords[i], numerals[i] = c.Name, c.Numeral
+				}
+				assert.Equal(t, tt.ExpectedChords, chords)
+				assert.Equal(t, tt.ExpectedNumerals, numerals)
+			})
+		}
+	})
+
+	t.Run("resolve secondary numerals in the key of their target", func(t *testing.T) {
+		p, err := ParseProgression(cMajor, "V7/ii ii V/V vii°7/V V")
+		assert.NoError(t, err)
+
+		assert.Equal(t, "A7", p.Chords[0].Name)
+		assert.Equal(t, "V7/ii", p.Chords[0].Numeral)
+		assert.True(t, p.Chords[0].Secondary)
+		assert.Equal(t, "D", p.Chords[2].Name)
+		assert.True(t, p.Chords[2].Secondary)
+		assert.Equal(t, "F#dim7", p.Chords[3].Name)
+		assert.False(t, p.Chords[4].Secondary)
+	})
+
+	t.Run("mark borrowed chords", func(t *testing.T) {
+		p, err := ParseProgression(cMajor, "I bVII iv I")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []bool{false, true, true, false}, []bool{p.Chords[0].Borrowed, p.Chords[1].Borrowed, p.Chords[2].Borrowed, p.Chords[3].Borrowed})
+		assert.Equal(t, "Bb", p.Chords[1].Name)
+		assert.Equal(t, "Fmin", p.Chords[2].Name)
+	})
+
+	t.Run("analyse chord names in a key", func(t *testing.T) {
+		p, err := ParseProgression(cMajor, "Dm7 G7 Cmaj7")
+		assert.NoError(t, err)
+
+		assert.Equal(t, "C major", p.Key.Name())
+		assert.Equal(t, []string{"ii7", "V7", "Imaj7"}, []string{p.Chords[0].Numeral, p.Chords[1].Numeral, p.Chords[2].Numeral})
+	})
+
+	t.Run("detect the key of chord names", func(t *testing.T) {
+		tests := []struct {
+			Progression      string
+			ExpectedKey      string
+			ExpectedNumerals []string
+		}{
+			{Progression: "Dm7 G7 Cmaj7", ExpectedKey: "C major", ExpectedNumerals: []string{"ii7", "V7", "Imaj7"}},
+			{Progression: "Am F C G", ExpectedKey: "A minor", ExpectedNumerals: []string{"i", "bVI", "bIII", "bVII"}},
+			{Progression: "F#m B7 Emaj7", ExpectedKey: "E major", ExpectedNumerals: []string{"ii", "V7", "Imaj7"}},
+			{Progression: "Ebmaj7 Cm7 Fm7 Bb7", ExpectedKey: "Eb major", ExpectedNumerals: []string{"Imaj7", "vi7", "ii7", "V7"}},
+			{Progression: "C A7 Dm G7 C", ExpectedKey: "C major", ExpectedNumerals: []string{"I", "V7/ii", "ii", "V7", "I"}},
+			{Progression: "C Bb F C", ExpectedKey: "C major", ExpectedNumerals: []string{"I", "bVII", "IV", "I"}},
+			{Progression: "E7 Am", ExpectedKey: "A minor", ExpectedNumerals: []string{"V7", "i"}},
+			{Progression: "am F C G", ExpectedKey: "A minor", ExpectedNumerals: []string{"i", "bVI", "bIII", "bVII"}},
+			{Progression: "dm7 g7 cmaj7", ExpectedKey: "C major", ExpectedNumerals: []string{"ii7", "V7", "Imaj7"}},
+			{Progression: "C-7 F7 Bbmaj7", ExpectedKey: "Bb major", ExpectedNumerals: []string{"ii7", "V7", "Imaj7"}},
+			{Progression: "Dm E7 Am", ExpectedKey: "A minor", ExpectedNumerals: []string{"iv", "V7", "i"}},
+			{Progression: "Dm7 G7 Cmaj7 Bb", ExpectedKey: "C major", ExpectedNumerals: []string{"ii7", "V7", "Imaj7", "bVII"}},
+			{Progression: "Bb Dm7 G7 Cmaj7", ExpectedKey: "C major", ExpectedNumerals: []string{"bVII", "ii7", "V7", "Imaj7"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Progression, func(t *testing.T) {
+				p, err := ParseProgression(Scale{}, tt.Progression)
+				assert.NoError(t, err)
+
+				numerals := make([]string, len(p.Chords))
+				for i, c := range p.Chords {
+					numerals[i] = c.Numeral
+				}
+				assert.Equal(t, tt.ExpectedKey, p.Key.Name())
+				assert.Equal(t, tt.ExpectedNumerals, numerals)
+			})
+		}
+	})
+
+	t.Run("return error for invalid progressions", func(t *testing.T) {
+		tests := []struct {
+			Progression   string
+			Key           Scale
+			ExpectedError string
+		}{
+			{Progression: " ", Key: cMajor, ExpectedError: "progression must contain at least one chord"},
+			{Progression: "I IV V", Key: Scale{}, ExpectedError: "a progression of Roman numerals needs a key"},
+			{Progression: "C vi F V", Key: Scale{}, ExpectedError: "a progression of Roman numerals needs a key"},
+			{Progression: "I W", Key: cMajor, ExpectedError: "invalid numeral W in progression: W is not a Roman numeral"},
+			{Progression: "V/vii", Key: cMajor, ExpectedError: "invalid numeral V/vii in progression: the diminished chord vii can't be a target"},
+			{Progression: "C Hm", Key: cMajor, ExpectedError: "could not create chord from name Hm: expected a root note from A to G"},
+			{Progression: "am hm", Key: Scale{}, ExpectedError: "could not create chord from name hm: expected a root note from A to G"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Progression, func(t *testing.T) {
+				_, err := ParseProgression(tt.Key, tt.Progression)
+				assert.EqualError(t, err, tt.ExpectedError)
+			})
+		}
+	})
+}
+
+func TestAnalyzeProgression(t *testing.T) {
+	key, _ := NewScale("C", ScaleMajor)
+	chords := make([]Chord, 0, 3)
+	for _, name := range []string{"C/E", "F#dim7", "G7"} {
+		c, _ := ParseChord(name)
+		chords = append(chords, c)
+	}
+
+	p := AnalyzeProgression(key, chords...)
+
+	assert.Equal(t, "I", p.Chords[0].Numeral)
+	assert.Equal(t, "vii°7/V", p.Chords[1].Numeral)
+	assert.True(t, p.Chords[1].Secondary)
+	assert.Equal(t, "V7", p.Chords[2].Numeral)
+}
+
+func TestDetectKey(t *testing.T) {
+	t.Run("return the zero Scale without chords", func(t *testing.T) {
+		assert.True(t, DetectKey().Root.IsZero())
+	})
+
+	t.Run("prefer the key of the tonic chord over its relative key", func(t *testing.T) {
+		tests := []struct {
+			Chords      []string
+			ExpectedKey string
+		}{
+			{Chords: []string{"E7", "Am"}, ExpectedKey: "A minor"},
+			{Chords: []string{"Am", "E7"}, ExpectedKey: "A minor"},
+			{Chords: []string{"G7", "C"}, ExpectedKey: "C major"},
+			{Chords: []string{"Dm7", "G7", "Cmaj7", "Bb"}, ExpectedKey: "C major"},
+			{Chords: []string{"Dm7", "G7", "Am"}, ExpectedKey: "A minor"},
+		}
+
+		for _, tt := range tests {
+			t.Run(strings.Join(tt.Chords, " "), func(t *testing.T) {
+				chords := make([]Chord, len(tt.Chords))
+				for i, name := range tt.Chords {
+					chords[i], _ = ParseChord(name)
+				}
+
+				assert.Equal(t, tt.ExpectedKey, DetectKey(chords...).Name())
+			})
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package fretboard
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	FamilyDiatonic      = "diatonic"
+	FamilyHarmonicMinor = "harmonic minor"
+	FamilyMelodicMinor  = "melodic minor"
+	FamilyPentatonic    = "pentatonic"
+	FamilyBlues         = "blues"
+	FamilyCustom        = "custom"
+)
+
+var DefaultScales = newDefaultScaleRegistry()
+
+type ScaleDefinition struct {
+	Name    string
+	Aliases []string
+	Formula string
+	Family  string
+	degrees []degree
+}
+
+type ScaleRegistry struct {
+	mu          sync.RWMutex
+	definitions []ScaleDefinition
+	index       map[string]int
+}
+
+func NewScaleRegistry() *ScaleRegistry {
+	return &ScaleRegistry{index: make(map[string]int)}
+}
+
+func RegisterScale(definition ScaleDefinition) error {
+	return DefaultScales.Register(definition)
+}
+
+func (r *ScaleRegistry) Register(definition ScaleDefinition) error {
+	if strings.TrimSpace(definition.Name) == "" {
+		return errors.New("name of a scale definition must not be empty")
+	}
+
+	degrees, err := parseFormula(definition.Formula)
+	if err != nil {
+		return fmt.Errorf("invalid formula %q for scale %s: %w", definition.Formula, definition.Name, err)
+	}
+	definition.degrees = degrees
+	if definition.Family == "" {
+		definition.Family = FamilyCustom
+	}
+	definition.Aliases = append([]string{}, definition.Aliases...)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := append([]string{definition.Name}, definition.Aliases...)
+	for _, name := range names {
+		if _, ok := r.index[registryKey(name)]; ok {
+			return fmt.Errorf("scale %s is already registered", name)
+		}
+	}
+
+	r.definitions = append(r.definitions, definition)
+	for _, name := range names {
+		r.index[registryKey(name)] = len(r.definitions) - 1
+	}
+
+	return nil
+}
+
+func (r *ScaleRegistry) Lookup(name string) (ScaleDefinition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.index[registryKey(name)]
+	if !ok {
+		return ScaleDefinition{}, false
+	}
+
+	return r.definitions[i], true
+}
+
+func (r *ScaleRegistry) Definitions() []ScaleDefinition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	definitions := make([]ScaleDefinition, len(r.definitions))
+	copy(definitions, r.definitions)
+	return definitions
+}
+
+func (r *ScaleRegistry) NewScale(rootNote string, scaleType string) (Scale, error) {
+	definition, ok := r.Lookup(scaleType)
+	if !ok {
+		return Scale{}, fmt.Errorf("scale type %s is not supported", scaleType)
+	}
+
+	root, err := NewNote(rootNote)
+	if err != nil {
+		return Scale{}, err
+	}
+
+	return newScaleFromDegrees(root, definition.Name, definition.degrees), nil
+}
+
+func registryKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func newDefaultScaleRegistry() *ScaleRegistry {
+	r := NewScaleRegistry()
+	definitions := []ScaleDefinition{
+		{Name: ScaleMajor, Aliases: []string{"ionian"}, Formula: "1 2 3 4 5 6 7", Family: FamilyDiatonic},
+		{Name: ScaleDorian, Formula: "1 2 b3 4 5 6 b7", Family: FamilyDiatonic},
+		{Name: ScalePhrygian, Formula: "1 b2 b3 4 5 b6 b7", Family: FamilyDiatonic},
+		{Name: ScaleLydian, Formula: "1 2 3 #4 5 6 7", Family: FamilyDiatonic},
+		{Name: ScaleMixolydian, Formula: "1 2 3 4 5 6 b7", Family: FamilyDiatonic},
+		{Name: ScaleMinor, Aliases: []string{"aeolian", "natural minor"}, Formula: "1 2 b3 4 5 b6 b7", Family: FamilyDiatonic},
+		{Name: ScaleLocrian, Formula: "1 b2 b3 4 b5 b6 b7", Family: FamilyDiatonic},
+
+		{Name: ScaleHarmonicMinor, Formula: "1 2 b3 4 5 b6 7", Family: FamilyHarmonicMinor},
+		{Name: ScaleLocrianNatural6, Aliases: []string{"locrian natural 6"}, Formula: "1 b2 b3 4 b5 6 b7", Family: FamilyHarmonicMinor},
+		{Name: ScaleIonianSharp5, Aliases: []string{"ionian augmented"}, Formula: "1 2 3 4 #5 6 7", Family: FamilyHarmonicMinor},
+		{Name: ScaleDorianSharp4, Aliases: []string{"ukrainian dorian"}, Formula: "1 2 b3 #4 5 6 b7", Family: FamilyHarmonicMinor},
+		{Name: ScalePhrygianDominant, Aliases: []string{"spanish phrygian", "freygish"}, Formula: "1 b2 3 4 5 b6 b7", Family: FamilyHarmonicMinor},
+		{Name: ScaleLydianSharp2, Formula: "1 #2 3 #4 5 6 7", Family: FamilyHarmonicMinor},
+		{Name: ScaleUltralocrian, Aliases: []string{"super locrian bb7"}, Formula: "1 b2 b3 b4 b5 b6 bb7", Family: FamilyHarmonicMinor},
+
+		{Name: ScaleMelodicMinor, Aliases: []string{"jazz minor"}, Formula: "1 2 b3 4 5 6 7", Family: FamilyMelodicMinor},
+		{Name: ScaleDorianFlat2, Aliases: []string{"phrygian #6"}, Formula: "1 b2 b3 4 5 6 b7", Family: FamilyMelodicMinor},
+		{Name: ScaleLydianAugmented, Formula: "1 2 3 #4 #5 6 7", Family: FamilyMelodicMinor},
+		{Name: ScaleLydianDominant, Aliases: []string{"overtone", "acoustic"}, Formula: "1 2 3 #4 5 6 b7", Family: FamilyMelodicMinor},
+		{Name: ScaleMixolydianFlat6, Aliases: []string{"aeolian dominant"}, Formula: "1 2 3 4 5 b6 b7", Family: FamilyMelodicMinor},
+		{Name: ScaleLocrianNatural2, Aliases: []string{"locrian natural 2", "half diminished"}, Formula: "1 2 b3 4 b5 b6 b7", Family: FamilyMelodicMinor},
+		{Name: ScaleAltered, Aliases: []string{"super locrian"}, Formula: "1 b2 b3 b4 b5 b6 b7", Family: FamilyMelodicMinor},
+
+		{Name: ScaleMinorPentatonic, Formula: "1 b3 4 5 b7", Family: FamilyPentatonic},
+		{Name: ScaleMajorPentatonic, Formula: "1 2 3 5 6", Family: FamilyPentatonic},
+		{Name: ScaleBlues, Formula: "1 b3 4 b5 5 b7", Family: FamilyBlues},
+	}
+
+	for _, d := range definitions {
+		if err := r.Register(d); err != nil {
+			panic(err)
+		}
+	}
+
+	return r
+}
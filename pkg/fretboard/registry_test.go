@@ -0,0 +1,80 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestScaleRegistry_Register(t *testing.T) {
+	t.Run("make a registered scale available by name and alias", func(t *testing.T) {
+		r := NewScaleRegistry()
+		err := r.Register(ScaleDefinition{Name: "hijaz", Aliases: []string{"hicaz"}, Formula: "1 b2 3 4 5 b6 b7"})
+		assert.NoError(t, err)
+
+		byName, ok := r.Lookup("hijaz")
+		assert.True(t, ok)
+		byAlias, ok := r.Lookup("Hicaz")
+		assert.True(t, ok)
+		assert.Equal(t, byName, byAlias)
+		assert.Equal(t, FamilyCustom, byName.Family)
+	})
+
+	t.Run("return error for an invalid definition", func(t *testing.T) {
+		tests := []struct {
+			Name       string
+			Definition ScaleDefinition
+		}{
+			{Name: "empty name", Definition: ScaleDefinition{Formula: "1 2 3"}},
+			{Name: "invalid formula", Definition: ScaleDefinition{Name: "broken", Formula: "1 2 z"}},
+			{Name: "name already registered", Definition: ScaleDefinition{Name: "Minor", Formula: "1 2 3"}},
+			{Name: "alias already registered", Definition: ScaleDefinition{Name: "other", Aliases: []string{"aeolian"}, Formula: "1 2 3"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				r := newDefaultScaleRegistry()
+				assert.Error(t, r.Register(tt.Definition))
+			})
+		}
+	})
+}
+
+func TestScaleRegistry_Definitions(t *testing.T) {
+	r := NewScaleRegistry()
+	_ = r.Register(ScaleDefinition{Name: "first", Formula: "1 3 5"})
+	_ = r.Register(ScaleDefinition{Name: "second", Formula: "1 b3 5", Family: FamilyPentatonic})
+
+	definitions := r.Definitions()
+
+	assert.Len(t, definitions, 2)
+	assert.Equal(t, "first", definitions[0].Name)
+	assert.Equal(t, "second", definitions[1].Name)
+	assert.Equal(t, FamilyPentatonic, definitions[1].Family)
+}
+
+func TestScaleRegistry_NewScale(t *testing.T) {
+	t.Run("resolve aliases to the canonical scale name", func(t *testing.T) {
+		tests := []struct {
+			Alias        string
+			ExpectedName string
+		}{
+			{Alias: "aeolian", ExpectedName: "A minor"},
+			{Alias: "Ionian", ExpectedName: "A major"},
+			{Alias: "super  locrian", ExpectedName: "A altered"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Alias, func(t *testing.T) {
+				scale, err := DefaultScales.NewScale("A", tt.Alias)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedName, scale.Name())
+			})
+		}
+	})
+
+	t.Run("return error for an unknown scale", func(t *testing.T) {
+		_, err := NewScaleRegistry().NewScale("A", ScaleMinor)
+		assert.Error(t, err)
+	})
+}
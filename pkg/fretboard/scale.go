@@ -1,30 +1,60 @@
 package fretboard
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 )
 
 const (
-	ScaleMinor string = "minor"
-	ScaleMajor        = "major"
+	ScaleMinor      string = "minor"
+	ScaleMajor             = "major"
+	ScaleDorian            = "dorian"
+	ScalePhrygian          = "phrygian"
+	ScaleLydian            = "lydian"
+	ScaleMixolydian        = "mixolydian"
+	ScaleLocrian           = "locrian"
+
+	ScaleHarmonicMinor    = "harmonic minor"
+	ScaleLocrianNatural6  = "locrian #6"
+	ScaleIonianSharp5     = "ionian #5"
+	ScaleDorianSharp4     = "dorian #4"
+	ScalePhrygianDominant = "phrygian dominant"
+	ScaleLydianSharp2     = "lydian #2"
+	ScaleUltralocrian     = "ultralocrian"
+
+	ScaleMelodicMinor    = "melodic minor"
+	ScaleDorianFlat2     = "dorian b2"
+	ScaleLydianAugmented = "lydian augmented"
+	ScaleLydianDominant  = "lydian dominant"
+	ScaleMixolydianFlat6 = "mixolydian b6"
+	ScaleLocrianNatural2 = "locrian #2"
+	ScaleAltered         = "altered"
+
+	ScaleMinorPentatonic = "minor pentatonic"
+	ScaleMajorPentatonic = "major pentatonic"
+	ScaleBlues           = "blues"
 )
 
-var (
-	notes                   = []string{"A", "A#", "B", "C", "C#", "D", "D#", "E", "F", "F#", "G", "G#"}
-	intervalPerfectUnison   = "1"
-	intervalMinorSecond     = "m2"
-	intervalMajorSecond     = "2"
-	intervalMinorThird      = "m3"
-	intervalMajorThird      = "3"
-	intervalPerfectFourth   = "4"
-	intervalDiminishedFifth = "b5"
-	intervalPerfectFifth    = "5"
-	intervalMinorSixth      = "m6"
-	intervalMajorSixth      = "6"
-	intervalMinorSeventh    = "m7"
-	intervalMajorSeventh    = "7"
+// ChordSize is the number of notes of a chord built from stacked thirds.
+type ChordSize uint
+
+const (
+	ChordSizeTriad ChordSize = iota + 3
+	ChordSizeSeventh
+	ChordSizeNinth
+	ChordSizeEleventh
+	ChordSizeThirteenth
 )
 
+// DiatonicChord is a chord built on a degree of a scale. Degree counts from 1 for the chord on
+// the root, Numeral is the Roman numeral relative to the major scale, e.g. "ii", "V7" or "bVII".
+type DiatonicChord struct {
+	Chord
+	Degree  int
+	Numeral string
+}
+
 type Scale struct {
 	Root      Note
 	notes     []Note
@@ -32,19 +62,47 @@ type Scale struct {
 }
 
 func NewScale(rootNote string, scaleType string) (Scale, error) {
+	return DefaultScales.NewScale(rootNote, scaleType)
+}
+
+func NewScaleFromFormula(rootNote string, name string, formula string) (Scale, error) {
+	degrees, err := parseFormula(formula)
+	if err != nil {
+		return Scale{}, fmt.Errorf("invalid formula %q: %w", formula, err)
+	}
+
+	return newCustomScale(rootNote, name, degrees)
+}
+
+func NewScaleFromSemitones(rootNote string, name string, semitones ...uint) (Scale, error) {
+	degrees, err := degreesFromSemitones(semitones...)
+	if err != nil {
+		return Scale{}, fmt.Errorf("invalid semitones %v: %w", semitones, err)
+	}
+
+	return newCustomScale(rootNote, name, degrees)
+}
+
+func newCustomScale(rootNote string, name string, degrees []degree) (Scale, error) {
+	if strings.TrimSpace(name) == "" {
+		return Scale{}, errors.New("name of a custom scale must not be empty")
+	}
+
 	root, err := NewNote(rootNote)
 	if err != nil {
 		return Scale{}, err
 	}
 
-	switch scaleType {
-	case ScaleMinor:
-		return Scale{Root: root, scaleType: scaleType, notes: buildScaleNotes(root, 2, 3, 5, 7, 8, 10)}, nil
-	case ScaleMajor:
-		return Scale{Root: root, scaleType: scaleType, notes: buildScaleNotes(root, 2, 4, 5, 7, 9, 11)}, nil
-	default:
-		return Scale{}, fmt.Errorf("scale type %s is not supported", scaleType)
+	return newScaleFromDegrees(root, name, degrees), nil
+}
+
+func newScaleFromDegrees(root Note, name string, degrees []degree) Scale {
+	notes := make([]Note, len(degrees))
+	for i, d := range degrees {
+		notes[i] = root.atDegree(d)
 	}
+
+	return Scale{Root: root, scaleType: name, notes: notes}
 }
 
 func (s Scale) Name() string {
@@ -55,6 +113,12 @@ func (s Scale) Name() string {
 	return fmt.Sprintf("%s %s", s.Root, s.scaleType)
 }
 
+func (s Scale) Notes() []Note {
+	notes := make([]Note, len(s.notes))
+	copy(notes, s.notes)
+	return notes
+}
+
 func (s Scale) Contains(note Note) bool {
 	for _, n := range s.notes {
 		if n.Equals(note) {
@@ -64,125 +128,98 @@ func (s Scale) Contains(note Note) bool {
 	return false
 }
 
-func (s Scale) Chords() []Chord {
-	chords := make([]Chord, len(s.notes))
-	for i, n := range s.notes {
-		chords[i] = NewChord(n, s.buildChordIntervals(n)...)
-	}
-	return chords
-}
-
-func (s Scale) buildChordIntervals(note Note) []uint {
-	numberOfThirds := 3
-	var addedIntervals uint
-
-	intervals := make([]uint, numberOfThirds)
-	for i := 0; i < numberOfThirds; i++ {
-		var interval uint = 3
-		if s.Contains(note.Add(4)) {
-			interval = 4
+func (s Scale) IntervalOf(note Note) (Interval, bool) {
+	for _, n := range s.notes {
+		if n.Equals(note) {
+			return s.Root.IntervalTo(n), true
 		}
-
-		note = note.Add(interval)
-		addedIntervals += interval
-		intervals[i] = addedIntervals
 	}
-
-	return intervals
+	return Interval{}, false
 }
 
-type Note struct {
-	value string
-}
-
-func NewNote(value string) (Note, error) {
-	for _, n := range notes {
-		if value == n {
-			return Note{value: n}, nil
+func (s Scale) spell(note Note) Note {
+	for _, n := range s.notes {
+		if n.Equals(note) {
+			return n
 		}
 	}
-
-	return Note{}, fmt.Errorf("note does not exist: %s", value)
+	return note
 }
 
-func (n Note) Equals(other Note) bool {
-	return n.value == other.value
+// Chords returns the diatonic seventh chords of the scale, see ChordsOfSize.
+func (s Scale) Chords() []DiatonicChord {
+	chords, _ := s.ChordsOfSize(ChordSizeSeventh)
+	return chords
 }
 
-func (n Note) Add(semitones uint) Note {
-	if semitones == 0 || semitones%12 == 0 {
-		return n
+// ChordsOfSize returns the diatonic chords of a seven-note scale, one for each degree, built by
+// stacking thirds until the chord has the given size. Thirteenth chords with a major third leave
+// out the eleventh, as it clashes with the third. Scales with more or fewer notes can't be
+// harmonised by stacking thirds, so for them only the chords of the given size whose notes are
+// all part of the scale are returned. Sizes from ChordSizeTriad to ChordSizeThirteenth are
+// supported.
+func (s Scale) ChordsOfSize(size ChordSize) ([]DiatonicChord, error) {
+	if size < ChordSizeTriad || size > ChordSizeThirteenth {
+		return nil, fmt.Errorf("chord size %d must be between %d and %d", size, ChordSizeTriad, ChordSizeThirteenth)
 	}
-	currentNoteIndex := findNoteIndex(n)
-
-	nextNoteIndex := currentNoteIndex + semitones
-	if int(nextNoteIndex) > 11 {
-		nextNoteIndex = nextNoteIndex % 12
+	if len(s.notes) != 7 {
+		return s.containedChords(size), nil
 	}
 
-	return Note{value: notes[nextNoteIndex]}
-}
-
-func (n Note) IntervalTo(other Note) string {
-	if n.Equals(other) {
-		return intervalPerfectUnison
-	}
-
-	currentNoteIndex := findNoteIndex(n)
-	otherNoteIndex := findNoteIndex(other)
-	semitones := int(otherNoteIndex) - int(currentNoteIndex)
-	if semitones < 0 {
-		semitones += 12
+	chords := make([]DiatonicChord, len(s.notes))
+	for i, n := range s.notes {
+		c, err := chordFromDegrees(n, s.stackThirds(i, size))
+		if err != nil {
+			return nil, err
+		}
+		chords[i] = s.diatonicChord(c, i+1)
 	}
+	return chords, nil
+}
 
-	switch semitones {
-	case 1:
-		return intervalMinorSecond
-	case 2:
-		return intervalMajorSecond
-	case 3:
-		return intervalMinorThird
-	case 4:
-		return intervalMajorThird
-	case 5:
-		return intervalPerfectFourth
-	case 6:
-		return intervalDiminishedFifth
-	case 7:
-		return intervalPerfectFifth
-	case 8:
-		return intervalMinorSixth
-	case 9:
-		return intervalMajorSixth
-	case 10:
-		return intervalMinorSeventh
-	case 11:
-		return intervalMajorSeventh
+func (s Scale) containedChords(size ChordSize) []DiatonicChord {
+	chords := make([]DiatonicChord, 0, len(s.notes))
+	for i, n := range s.notes {
+		for _, ct := range chordTypes {
+			if !ct.isTertian(int(size)) {
+				continue
+			}
+
+			chord := newChord(n, ct.suffix, ct.degrees)
+			if s.containsAll(chord.notes) {
+				chords = append(chords, s.diatonicChord(chord, i+1))
+				break
+			}
+		}
 	}
-
-	return ""
+	return chords
 }
 
-func (n Note) String() string {
-	return n.value
+func (s Scale) diatonicChord(c Chord, degree int) DiatonicChord {
+	return DiatonicChord{Chord: c, Degree: degree, Numeral: romanNumeral(s.Root, c)}
 }
 
-func buildScaleNotes(root Note, intervals ...uint) []Note {
-	notes := make([]Note, len(intervals)+1)
-	notes[0] = root
-	for i, interval := range intervals {
-		notes[i+1] = root.Add(interval)
+func (s Scale) containsAll(notes []Note) bool {
+	for _, n := range notes {
+		if !s.Contains(n) {
+			return false
+		}
 	}
-
-	return notes
+	return true
 }
 
-func findNoteIndex(note Note) uint {
-	for i, n := range notes {
-		if n == note.value {
-			return uint(i)
-		}
+func (s Scale) stackThirds(index int, size ChordSize) []degree {
+	root := s.notes[index]
+	degrees := make([]degree, 0, size)
+	for i := 0; i < int(size); i++ {
+		d := degree{number: 2*i + 1}
+		note := s.notes[(index+2*i)%len(s.notes)]
+		d.alteration = ((int(root.semitonesTo(note))-d.semitones())%12+18)%12 - 6
+		degrees = append(degrees, d)
 	}
 
-	panic(fmt.Sprintf("could not determine index for note %v", note))
+	if size == ChordSizeThirteenth && degrees[1] == (degree{number: 3}) && degrees[5] == (degree{number: 11}) {
+		degrees = append(degrees[:5], degrees[6])
+	}
+	return degrees
 }
@@ -19,6 +19,15 @@ func TestScale_Chords(t *testing.T) {
 		assert.Equal(t, "Bmin7b5", chords[6].Name)
 	})
 
+	t.Run("create correctly spelled chords for a scale with flats", func(t *testing.T) {
+		scale, _ := NewScale("F", ScaleMajor)
+		chords := scale.Chords()
+
+		assert.Equal(t, "Bbmaj7", chords[3].Name)
+		assert.Equal(t, []Note{mustParseNote("Bb"), mustParseNote("D"), mustParseNote("F"), mustParseNote("A")}, chords[3].Notes())
+		assert.Equal(t, []Note{mustParseNote("C"), mustParseNote("E"), mustParseNote("G"), mustParseNote("Bb")}, chords[4].Notes())
+	})
+
 	t.Run("create chords for a minor scale", func(t *testing.T) {
 		scale, _ := NewScale("A", ScaleMinor)
 		chords := scale.Chords()
@@ -31,22 +40,246 @@ func TestScale_Chords(t *testing.T) {
 		assert.Equal(t, "Fmaj7", chords[5].Name)
 		assert.Equal(t, "G7", chords[6].Name)
 	})
+
+	t.Run("create chords for the diatonic modes", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Root           string
+			ScaleType      string
+			ExpectedChords []string
+		}{
+			{Name: "dorian", Root: "D", ScaleType: ScaleDorian, ExpectedChords: []string{"Dmin7", "Emin7", "Fmaj7", "G7", "Amin7", "Bmin7b5", "Cmaj7"}},
+			{Name: "phrygian", Root: "E", ScaleType: ScalePhrygian, ExpectedChords: []string{"Emin7", "Fmaj7", "G7", "Amin7", "Bmin7b5", "Cmaj7", "Dmin7"}},
+			{Name: "lydian", Root: "F", ScaleType: ScaleLydian, ExpectedChords: []string{"Fmaj7", "G7", "Amin7", "Bmin7b5", "Cmaj7", "Dmin7", "Emin7"}},
+			{Name: "mixolydian", Root: "G", ScaleType: ScaleMixolydian, ExpectedChords: []string{"G7", "Amin7", "Bmin7b5", "Cmaj7", "Dmin7", "Emin7", "Fmaj7"}},
+			{Name: "locrian", Root: "B", ScaleType: ScaleLocrian, ExpectedChords: []string{"Bmin7b5", "Cmaj7", "Dmin7", "Emin7", "Fmaj7", "G7", "Amin7"}},
+			{Name: "harmonic minor", Root: "A", ScaleType: ScaleHarmonicMinor, ExpectedChords: []string{"AminMaj7", "Bmin7b5", "Cmaj7#5", "Dmin7", "E7", "Fmaj7", "G#dim7"}},
+			{Name: "phrygian dominant", Root: "E", ScaleType: ScalePhrygianDominant, ExpectedChords: []string{"E7", "Fmaj7", "G#dim7", "AminMaj7", "Bmin7b5", "Cmaj7#5", "Dmin7"}},
+			{Name: "melodic minor", Root: "A", ScaleType: ScaleMelodicMinor, ExpectedChords: []string{"AminMaj7", "Bmin7", "Cmaj7#5", "D7", "E7", "F#min7b5", "G#min7b5"}},
+			{Name: "lydian dominant", Root: "D", ScaleType: ScaleLydianDominant, ExpectedChords: []string{"D7", "E7", "F#min7b5", "G#min7b5", "AminMaj7", "Bmin7", "Cmaj7#5"}},
+			{Name: "altered", Root: "G#", ScaleType: ScaleAltered, ExpectedChords: []string{"G#min7b5", "AminMaj7", "Bmin7", "Cmaj7#5", "D7", "E7", "F#min7b5"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				scale, err := NewScale(tt.Root, tt.ScaleType)
+				assert.NoError(t, err)
+
+				chords := scale.Chords()
+				names := make([]string, len(chords))
+				for i, c := range chords {
+					names[i] = c.Name
+				}
+				assert.Equal(t, tt.ExpectedChords, names)
+			})
+		}
+	})
+}
+
+func TestScale_ChordsOfSize(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Root             string
+		ScaleType        string
+		Size             ChordSize
+		ExpectedChords   []string
+		ExpectedNumerals []string
+	}{
+		{
+			Name: "major triads", Root: "C", ScaleType: ScaleMajor, Size: ChordSizeTriad,
+			ExpectedChords:   []string{"C", "Dmin", "Emin", "F", "G", "Amin", "Bdim"},
+			ExpectedNumerals: []string{"I", "ii", "iii", "IV", "V", "vi", "vii°"},
+		},
+		{
+			Name: "major sevenths", Root: "C", ScaleType: ScaleMajor, Size: ChordSizeSeventh,
+			ExpectedChords:   []string{"Cmaj7", "Dmin7", "Emin7", "Fmaj7", "G7", "Amin7", "Bmin7b5"},
+			ExpectedNumerals: []string{"Imaj7", "ii7", "iii7", "IVmaj7", "V7", "vi7", "viiø7"},
+		},
+		{
+			Name: "major ninths", Root: "C", ScaleType: ScaleMajor, Size: ChordSizeNinth,
+			ExpectedChords:   []string{"Cmaj9", "Dmin9", "Emin7b9", "Fmaj9", "G9", "Amin9", "Bmin7b5b9"},
+			ExpectedNumerals: []string{"Imaj9", "ii9", "iii7b9", "IVmaj9", "V9", "vi9", "viiø7b9"},
+		},
+		{
+			Name: "major elevenths", Root: "C", ScaleType: ScaleMajor, Size: ChordSizeEleventh,
+			ExpectedChords:   []string{"Cmaj11", "Dmin11", "Emin11b9", "Fmaj9#11", "G11", "Amin11", "Bmin11b5b9"},
+			ExpectedNumerals: []string{"Imaj11", "ii11", "iii11b9", "IVmaj9#11", "V11", "vi11", "viiø11b9"},
+		},
+		{
+			Name: "major thirteenths", Root: "C", ScaleType: ScaleMajor, Size: ChordSizeThirteenth,
+			ExpectedChords:   []string{"Cmaj13", "Dmin13", "Emin11b9b13", "Fmaj13#11", "G13", "Amin11b13", "Bmin11b5b9b13"},
+			ExpectedNumerals: []string{"Imaj13", "ii13", "iii11b9b13", "IVmaj13#11", "V13", "vi11b13", "viiø11b9b13"},
+		},
+		{
+			Name: "minor triads", Root: "A", ScaleType: ScaleMinor, Size: ChordSizeTriad,
+			ExpectedChords:   []string{"Amin", "Bdim", "C", "Dmin", "Emin", "F", "G"},
+			ExpectedNumerals: []string{"i", "ii°", "bIII", "iv", "v", "bVI", "bVII"},
+		},
+		{
+			Name: "harmonic minor sevenths", Root: "A", ScaleType: ScaleHarmonicMinor, Size: ChordSizeSeventh,
+			ExpectedChords:   []string{"AminMaj7", "Bmin7b5", "Cmaj7#5", "Dmin7", "E7", "Fmaj7", "G#dim7"},
+			ExpectedNumerals: []string{"imaj7", "iiø7", "bIIImaj7#5", "iv7", "V7", "bVImaj7", "vii°7"},
+		},
+		{
+			Name: "lydian triads", Root: "F", ScaleType: ScaleLydian, Size: ChordSizeTriad,
+			ExpectedChords:   []string{"F", "G", "Amin", "Bdim", "C", "Dmin", "Emin"},
+			ExpectedNumerals: []string{"I", "II", "iii", "#iv°", "V", "vi", "vii"},
+		},
+		{
+			Name: "minor pentatonic triads", Root: "A", ScaleType: ScaleMinorPentatonic, Size: ChordSizeTriad,
+			ExpectedChords:   []string{"Amin", "C"},
+			ExpectedNumerals: []string{"i", "bIII"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			scale, err := NewScale(tt.Root, tt.ScaleType)
+			assert.NoError(t, err)
+
+			chords, err := scale.ChordsOfSize(tt.Size)
+			assert.NoError(t, err)
+			names, numerals := make([]string, len(chords)), make([]string, len(chords))
+			for i, c := range chords {
+				names[i], numerals[i] = c.Name, c.Numeral
+			}
+			assert.Equal(t, tt.ExpectedChords, names)
+			assert.Equal(t, tt.ExpectedNumerals, numerals)
+		})
+	}
+
+	t.Run("return error for an unsupported size", func(t *testing.T) {
+		tests := []struct {
+			Name      string
+			ScaleType string
+			Size      ChordSize
+		}{
+			{Name: "no notes", ScaleType: ScaleMajor, Size: 0},
+			{Name: "single notes", ScaleType: ScaleMajor, Size: 1},
+			{Name: "dyads", ScaleType: ScaleMajor, Size: 2},
+			{Name: "beyond the thirteenth", ScaleType: ScaleMajor, Size: 8},
+			{Name: "two octaves", ScaleType: ScaleMajor, Size: 9},
+			{Name: "dyads of a pentatonic scale", ScaleType: ScaleMinorPentatonic, Size: 2},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				scale, _ := NewScale("C", tt.ScaleType)
+
+				chords, err := scale.ChordsOfSize(tt.Size)
+
+				assert.Error(t, err)
+				assert.Empty(t, chords)
+			})
+		}
+	})
+
+	t.Run("number the degrees of the scale", func(t *testing.T) {
+		scale, _ := NewScale("C", ScaleMinorPentatonic)
+		chords, _ := scale.ChordsOfSize(ChordSizeTriad)
+
+		assert.Equal(t, 1, chords[0].Degree)
+		assert.Equal(t, 2, chords[1].Degree)
+
+		scale, _ = NewScale("C", ScaleMajor)
+		chords, _ = scale.ChordsOfSize(ChordSizeNinth)
+		for i, c := range chords {
+			assert.Equal(t, i+1, c.Degree)
+		}
+	})
+
+	t.Run("name chords that can be parsed again", func(t *testing.T) {
+		for _, scaleType := range []string{ScaleMajor, ScaleMinor, ScaleHarmonicMinor, ScaleMelodicMinor} {
+			scale, _ := NewScale("Eb", scaleType)
+			for size := ChordSizeTriad; size <= ChordSizeThirteenth; size++ {
+				chords, err := scale.ChordsOfSize(size)
+				assert.NoError(t, err)
+				for _, c := range chords {
+					parsed, err := ParseChord(c.Name)
+
+					assert.NoError(t, err)
+					assert.Equal(t, c.Notes(), parsed.Notes(), "chord %s", c.Name)
+				}
+			}
+		}
+	})
+}
+
+func TestScale_Chords_NonHeptatonic(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Root           string
+		ScaleType      string
+		ExpectedChords []string
+	}{
+		{Name: "minor pentatonic", Root: "A", ScaleType: ScaleMinorPentatonic, ExpectedChords: []string{"Amin7"}},
+		{Name: "major pentatonic", Root: "C", ScaleType: ScaleMajorPentatonic, ExpectedChords: []string{"Amin7"}},
+		{Name: "blues", Root: "E", ScaleType: ScaleBlues, ExpectedChords: []string{"Emin7"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			scale, err := NewScale(tt.Root, tt.ScaleType)
+			assert.NoError(t, err)
+
+			chords := scale.Chords()
+			names := make([]string, len(chords))
+			for i, c := range chords {
+				names[i] = c.Name
+			}
+			assert.Equal(t, tt.ExpectedChords, names)
+		})
+	}
 }
 
 func TestScale_Root(t *testing.T) {
 	scale, _ := NewScale("A", ScaleMinor)
-	assert.Equal(t, Note{value: "A"}, scale.Root)
+	assert.Equal(t, mustParseNote("A"), scale.Root)
 }
 
 func TestScale_Contains(t *testing.T) {
 	t.Run("return true if scale contains note", func(t *testing.T) {
 		scale, _ := NewScale("A", ScaleMinor)
-		assert.True(t, scale.Contains(Note{value: "C"}))
+		assert.True(t, scale.Contains(mustParseNote("C")))
 	})
 
 	t.Run("return false if scale does not contain note", func(t *testing.T) {
 		scale, _ := NewScale("A", ScaleMinor)
-		assert.False(t, scale.Contains(Note{value: "C#"}))
+		assert.False(t, scale.Contains(mustParseNote("C#")))
+	})
+}
+
+func TestScale_IntervalOf(t *testing.T) {
+	t.Run("use the scale's spelling for the interval", func(t *testing.T) {
+		tests := []struct {
+			Root             string
+			ScaleType        string
+			Note             string
+			ExpectedInterval string
+		}{
+			{Root: "C", ScaleType: ScaleLydian, Note: "Gb", ExpectedInterval: "#4"},
+			{Root: "C", ScaleType: ScaleLocrian, Note: "F#", ExpectedInterval: "b5"},
+			{Root: "A", ScaleType: ScaleHarmonicMinor, Note: "Ab", ExpectedInterval: "7"},
+			{Root: "C", ScaleType: ScaleLydianSharp2, Note: "Eb", ExpectedInterval: "#2"},
+			{Root: "A", ScaleType: ScaleBlues, Note: "D#", ExpectedInterval: "b5"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Root+" "+tt.ScaleType, func(t *testing.T) {
+				scale, _ := NewScale(tt.Root, tt.ScaleType)
+				interval, ok := scale.IntervalOf(mustParseNote(tt.Note))
+
+				assert.True(t, ok)
+				assert.Equal(t, tt.ExpectedInterval, interval.String())
+			})
+		}
+	})
+
+	t.Run("return false if the note is not in the scale", func(t *testing.T) {
+		scale, _ := NewScale("C", ScaleMajor)
+		_, ok := scale.IntervalOf(mustParseNote("C#"))
+
+		assert.False(t, ok)
 	})
 }
 
@@ -70,8 +303,8 @@ func TestNewScale(t *testing.T) {
 	t.Run("build correct natural minor scale", func(t *testing.T) {
 		testScale, err := NewScale("A", ScaleMinor)
 		expectedScale := Scale{
-			Root:      Note{value: "A"},
-			notes:     []Note{{value: "A"}, {value: "B"}, {value: "C"}, {value: "D"}, {value: "E"}, {value: "F"}, {value: "G"}},
+			Root:      mustParseNote("A"),
+			notes:     []Note{mustParseNote("A"), mustParseNote("B"), mustParseNote("C"), mustParseNote("D"), mustParseNote("E"), mustParseNote("F"), mustParseNote("G")},
 			scaleType: ScaleMinor,
 		}
 
@@ -82,96 +315,84 @@ func TestNewScale(t *testing.T) {
 	t.Run("build correct major scale", func(t *testing.T) {
 		testScale, err := NewScale("C", ScaleMajor)
 		expectedScale := Scale{
-			Root:      Note{value: "C"},
-			notes:     []Note{{value: "C"}, {value: "D"}, {value: "E"}, {value: "F"}, {value: "G"}, {value: "A"}, {value: "B"}},
+			Root:      mustParseNote("C"),
+			notes:     []Note{mustParseNote("C"), mustParseNote("D"), mustParseNote("E"), mustParseNote("F"), mustParseNote("G"), mustParseNote("A"), mustParseNote("B")},
 			scaleType: ScaleMajor,
 		}
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedScale, testScale)
 	})
-}
 
-func TestNewNote(t *testing.T) {
-	t.Run("return note struct with correct value", func(t *testing.T) {
-		n, err := NewNote("A")
+	t.Run("build correctly spelled scales from the scale type", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			Root          string
+			ScaleType     string
+			ExpectedNotes []string
+		}{
+			{Name: "dorian", Root: "A", ScaleType: ScaleDorian, ExpectedNotes: []string{"A", "B", "C", "D", "E", "F#", "G"}},
+			{Name: "phrygian", Root: "A", ScaleType: ScalePhrygian, ExpectedNotes: []string{"A", "Bb", "C", "D", "E", "F", "G"}},
+			{Name: "lydian", Root: "A", ScaleType: ScaleLydian, ExpectedNotes: []string{"A", "B", "C#", "D#", "E", "F#", "G#"}},
+			{Name: "mixolydian", Root: "A", ScaleType: ScaleMixolydian, ExpectedNotes: []string{"A", "B", "C#", "D", "E", "F#", "G"}},
+			{Name: "locrian", Root: "A", ScaleType: ScaleLocrian, ExpectedNotes: []string{"A", "Bb", "C", "D", "Eb", "F", "G"}},
+			{Name: "harmonic minor", Root: "A", ScaleType: ScaleHarmonicMinor, ExpectedNotes: []string{"A", "B", "C", "D", "E", "F", "G#"}},
+			{Name: "phrygian dominant", Root: "E", ScaleType: ScalePhrygianDominant, ExpectedNotes: []string{"E", "F", "G#", "A", "B", "C", "D"}},
+			{Name: "melodic minor", Root: "A", ScaleType: ScaleMelodicMinor, ExpectedNotes: []string{"A", "B", "C", "D", "E", "F#", "G#"}},
+			{Name: "lydian dominant", Root: "D", ScaleType: ScaleLydianDominant, ExpectedNotes: []string{"D", "E", "F#", "G#", "A", "B", "C"}},
+			{Name: "altered", Root: "G#", ScaleType: ScaleAltered, ExpectedNotes: []string{"G#", "A", "B", "C", "D", "E", "F#"}},
+			{Name: "major with flats", Root: "F", ScaleType: ScaleMajor, ExpectedNotes: []string{"F", "G", "A", "Bb", "C", "D", "E"}},
+			{Name: "major from a flat root", Root: "Gb", ScaleType: ScaleMajor, ExpectedNotes: []string{"Gb", "Ab", "Bb", "Cb", "Db", "Eb", "F"}},
+			{Name: "harmonic minor with double sharp", Root: "G#", ScaleType: ScaleHarmonicMinor, ExpectedNotes: []string{"G#", "A#", "B", "C#", "D#", "E", "F##"}},
+			{Name: "ultralocrian with double flat", Root: "C", ScaleType: ScaleUltralocrian, ExpectedNotes: []string{"C", "Db", "Eb", "Fb", "Gb", "Ab", "Bbb"}},
+			{Name: "minor pentatonic", Root: "A", ScaleType: ScaleMinorPentatonic, ExpectedNotes: []string{"A", "C", "D", "E", "G"}},
+			{Name: "major pentatonic", Root: "C", ScaleType: ScaleMajorPentatonic, ExpectedNotes: []string{"C", "D", "E", "G", "A"}},
+			{Name: "blues", Root: "E", ScaleType: ScaleBlues, ExpectedNotes: []string{"E", "G", "A", "Bb", "B", "D"}},
+		}
 
-		assert.NoError(t, err)
-		assert.Equal(t, "A", n.String())
-	})
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				testScale, err := NewScale(tt.Root, tt.ScaleType)
+				assert.NoError(t, err)
 
-	t.Run("return error when note does not exist", func(t *testing.T) {
-		_, err := NewNote("M")
-		assert.Error(t, err)
+				notes := make([]string, len(testScale.notes))
+				for i, n := range testScale.notes {
+					notes[i] = n.String()
+				}
+				assert.Equal(t, tt.ExpectedNotes, notes)
+			})
+		}
 	})
 }
 
-func TestNote_Equals(t *testing.T) {
-	t.Run("return true when both notes have the same value", func(t *testing.T) {
-		a, b := Note{value: "C"}, Note{value: "C"}
+func TestNewScaleFromFormula(t *testing.T) {
+	t.Run("build scale with a custom name", func(t *testing.T) {
+		scale, err := NewScaleFromFormula("E", "hijaz", "1 b2 3 4 5 b6 b7")
 
-		assert.True(t, a.Equals(b))
+		assert.NoError(t, err)
+		assert.Equal(t, "E hijaz", scale.Name())
+		assert.Equal(t, []Note{mustParseNote("E"), mustParseNote("F"), mustParseNote("G#"), mustParseNote("A"), mustParseNote("B"), mustParseNote("C"), mustParseNote("D")}, scale.notes)
 	})
 
-	t.Run("return false when notes have different values", func(t *testing.T) {
-		a, b := Note{value: "C"}, Note{value: "C#"}
-
-		assert.False(t, a.Equals(b))
+	t.Run("return error for an invalid formula", func(t *testing.T) {
+		_, err := NewScaleFromFormula("E", "broken", "1 b2 x3")
+		assert.EqualError(t, err, `invalid formula "1 b2 x3": degree x3 is not a number with optional b or # prefix`)
 	})
-}
-
-func TestNote_Add(t *testing.T) {
-	tests := []struct {
-		Name         string
-		StartNote    string
-		Halfsteps    uint
-		ExpectedNote string
-	}{
-		{Name: "Add 0 semitones from A", StartNote: "A", Halfsteps: 0, ExpectedNote: "A"},
-		{Name: "Add 1 semitone from A", StartNote: "A", Halfsteps: 1, ExpectedNote: "A#"},
-		{Name: "Add 2 semitones from A", StartNote: "A", Halfsteps: 2, ExpectedNote: "B"},
-		{Name: "Add 12 semitones from A", StartNote: "A", Halfsteps: 12, ExpectedNote: "A"},
-		{Name: "Add 13 semitones from A", StartNote: "A", Halfsteps: 13, ExpectedNote: "A#"},
-		{Name: "Add 1 semitone from E", StartNote: "E", Halfsteps: 1, ExpectedNote: "F"},
-		{Name: "Add 5 semitones from E", StartNote: "E", Halfsteps: 5, ExpectedNote: "A"},
-		{Name: "Add 22 semitones from E", StartNote: "E", Halfsteps: 22, ExpectedNote: "D"},
-	}
 
-	for _, tt := range tests {
-		t.Run(tt.Name, func(t *testing.T) {
-			start := Note{value: tt.StartNote}
-			result := start.Add(tt.Halfsteps)
+	t.Run("return error for an empty name", func(t *testing.T) {
+		_, err := NewScaleFromFormula("E", "", "1 2 3")
+		assert.Error(t, err)
+	})
 
-			assert.Equal(t, tt.ExpectedNote, result.String())
-		})
-	}
+	t.Run("return error when root note does not exist", func(t *testing.T) {
+		_, err := NewScaleFromFormula("M", "custom", "1 2 3")
+		assert.Error(t, err)
+	})
 }
 
-func TestNote_IntervalTo(t *testing.T) {
-	tests := []struct {
-		Name             string
-		FirstNoteValue   string
-		SecondNoteValue  string
-		ExpectedInterval string
-	}{
-		{Name: "perfect unison for equal notes", FirstNoteValue: "G", SecondNoteValue: "G", ExpectedInterval: intervalPerfectUnison},
-		{Name: "minor second for a single semitone", FirstNoteValue: "G", SecondNoteValue: "G#", ExpectedInterval: intervalMinorSecond},
-		{Name: "major second for two semitones", FirstNoteValue: "G", SecondNoteValue: "A", ExpectedInterval: intervalMajorSecond},
-		{Name: "minor third for three semitones", FirstNoteValue: "G", SecondNoteValue: "A#", ExpectedInterval: intervalMinorThird},
-		{Name: "major third for four semitones", FirstNoteValue: "G", SecondNoteValue: "B", ExpectedInterval: intervalMajorThird},
-		{Name: "perfect fourth for five semitones", FirstNoteValue: "G", SecondNoteValue: "C", ExpectedInterval: intervalPerfectFourth},
-		{Name: "diminished fifth for 6 semitones", FirstNoteValue: "G", SecondNoteValue: "C#", ExpectedInterval: intervalDiminishedFifth},
-		{Name: "perfect fifth for seven semitones", FirstNoteValue: "G", SecondNoteValue: "D", ExpectedInterval: intervalPerfectFifth},
-		{Name: "minor sixth for eight semitones", FirstNoteValue: "G", SecondNoteValue: "D#", ExpectedInterval: intervalMinorSixth},
-		{Name: "major sixth for nine semitones", FirstNoteValue: "G", SecondNoteValue: "E", ExpectedInterval: intervalMajorSixth},
-		{Name: "minor seventh for ten semitones", FirstNoteValue: "G", SecondNoteValue: "F", ExpectedInterval: intervalMinorSeventh},
-		{Name: "major seventh for nine semitones", FirstNoteValue: "G", SecondNoteValue: "F#", ExpectedInterval: intervalMajorSeventh},
-	}
+func TestNewScaleFromSemitones(t *testing.T) {
+	scale, err := NewScaleFromSemitones("A", "minor pentatonic", 0, 3, 5, 7, 10)
 
-	for _, tt := range tests {
-		t.Run(tt.Name, func(t *testing.T) {
-			n1, n2 := Note{value: tt.FirstNoteValue}, Note{value: tt.SecondNoteValue}
-			assert.Equal(t, tt.ExpectedInterval, n1.IntervalTo(n2))
-		})
-	}
+	assert.NoError(t, err)
+	assert.Equal(t, []Note{mustParseNote("A"), mustParseNote("C"), mustParseNote("D"), mustParseNote("E"), mustParseNote("G")}, scale.notes)
 }
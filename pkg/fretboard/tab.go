@@ -0,0 +1,144 @@
+package fretboard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TabDirection is the order in which a scale run is played. The zero value plays the run up and
+// back down again.
+type TabDirection uint
+
+const (
+	TabDirectionUpDown TabDirection = iota
+	TabDirectionUp
+	TabDirectionDown
+)
+
+// ParseTabDirection accepts "up", "down" and "up-down".
+func ParseTabDirection(direction string) (TabDirection, error) {
+	switch strings.ToLower(strings.TrimSpace(direction)) {
+	case "up-down", "updown", "":
+		return TabDirectionUpDown, nil
+	case "up":
+		return TabDirectionUp, nil
+	case "down":
+		return TabDirectionDown, nil
+	default:
+		return 0, fmt.Errorf("direction %s must be up, down or up-down", direction)
+	}
+}
+
+// TabOptions configure a scale run. NotesPerString limits the notes played on every string, e.g.
+// to 3 for a three-notes-per-string pattern, 0 playing every highlighted note of the position.
+type TabOptions struct {
+	NotesPerString uint
+	Direction      TabDirection
+}
+
+// TabNote is a note of a scale run, played on the given string and fret.
+type TabNote struct {
+	String uint
+	Fret   uint
+	Note   Note
+}
+
+// Tab is a scale run written as guitar tablature, see Fretboard.Tab.
+type Tab struct {
+	Notes  []TabNote
+	tuning Tuning
+}
+
+// Tab returns a run through the highlighted notes of the shown frets, from the lowest to the
+// highest string and played in the given direction. The open strings are part of the run if the
+// nut is shown. Every note is higher than the one before, so notes that can be played on two
+// strings are played on the lower one. With a number of notes per string the run starts at the
+// first shown fret and may leave the shown frets towards the body, as the pattern needs more room
+// than a position.
+func (f *Fretboard) Tab(options TabOptions) (Tab, error) {
+	first, last := f.StartFret, f.EndFret
+	if f.ShowsNut() {
+		first = 0
+	}
+	if options.NotesPerString > 0 {
+		last = f.Frets
+	}
+
+	var notes []TabNote
+	lastPitch := -1
+	for s := f.Strings; s >= 1; s-- {
+		count := uint(0)
+		for fret := first; fret <= last; fret++ {
+			if options.NotesPerString > 0 && count == options.NotesPerString {
+				break
+			}
+
+			fr, err := f.Fret(s, fret)
+			if err != nil {
+				return Tab{}, err
+			}
+			if !fr.Highlighted || fr.Pitch.MIDI() <= lastPitch {
+				continue
+			}
+
+			notes = append(notes, TabNote{String: s, Fret: fret, Note: fr.Note})
+			lastPitch = fr.Pitch.MIDI()
+			count++
+		}
+	}
+	if len(notes) == 0 {
+		return Tab{}, fmt.Errorf("no notes are highlighted between frets %d and %d", first, last)
+	}
+
+	switch options.Direction {
+	case TabDirectionDown:
+		notes = reverseTabNotes(notes)
+	case TabDirectionUpDown:
+		notes = append(notes, reverseTabNotes(notes[:len(notes)-1])...)
+	}
+
+	return Tab{Notes: notes, tuning: f.Tuning}, nil
+}
+
+// String writes the tab with a line for every string, the highest string at the top and each
+// line labelled with the note of the open string, e.g. "E|-5-7-8-|".
+func (t Tab) String() string {
+	strs := t.tuning.Strings()
+	labels := make([]string, strs)
+	labelWidth := 0
+	for i, n := range t.tuning.Notes() {
+		labels[strs-1-uint(i)] = n.String()
+		labelWidth = max(labelWidth, len(n.String()))
+	}
+
+	fretWidth := 1
+	for _, n := range t.Notes {
+		fretWidth = max(fretWidth, len(strconv.Itoa(int(n.Fret))))
+	}
+
+	lines := make([]string, strs)
+	for s := uint(1); s <= strs; s++ {
+		var b strings.Builder
+		b.WriteString(fmt.Sprintf("%-*s|", labelWidth, labels[s-1]))
+		for _, n := range t.Notes {
+			cell := ""
+			if n.String == s {
+				cell = strconv.Itoa(int(n.Fret))
+			}
+			b.WriteString("-" + cell + strings.Repeat("-", fretWidth-len(cell)))
+		}
+		b.WriteString("-|")
+		lines[s-1] = b.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func reverseTabNotes(notes []TabNote) []TabNote {
+	reversed := make([]TabNote, len(notes))
+	for i, n := range notes {
+		reversed[len(notes)-1-i] = n
+	}
+	return reversed
+}
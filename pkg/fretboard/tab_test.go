@@ -0,0 +1,95 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseTabDirection(t *testing.T) {
+	t.Run("accept the directions of a run", func(t *testing.T) {
+		tests := []struct {
+			Direction string
+			Expected  TabDirection
+		}{
+			{Direction: "up", Expected: TabDirectionUp},
+			{Direction: "Down", Expected: TabDirectionDown},
+			{Direction: "up-down", Expected: TabDirectionUpDown},
+			{Direction: "", Expected: TabDirectionUpDown},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Direction, func(t *testing.T) {
+				direction, err := ParseTabDirection(tt.Direction)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, direction)
+			})
+		}
+	})
+
+	t.Run("return error for an unknown direction", func(t *testing.T) {
+		_, err := ParseTabDirection("sideways")
+		assert.Error(t, err)
+	})
+}
+
+func TestFretboard_Tab(t *testing.T) {
+	t.Run("play every highlighted note of the position", func(t *testing.T) {
+		fb, _ := New(Options{StartFret: 5, EndFret: 8})
+		scale, _ := NewScale("A", ScaleMinor)
+		fb.HighlightScale(scale)
+
+		tab, err := fb.Tab(TabOptions{Direction: TabDirectionUp})
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"E|---------------------------5-7-8-|",
+			"B|---------------------5-6-8-------|",
+			"G|-----------------5-7-------------|",
+			"D|-------------5-7-----------------|",
+			"A|-------5-7-8---------------------|",
+			"E|-5-7-8---------------------------|",
+		}, "\n"), tab.String())
+	})
+
+	t.Run("play a number of notes per string up and down", func(t *testing.T) {
+		fb, _ := New(Options{StartFret: 5, EndFret: 8})
+		scale, _ := NewScale("A", ScaleMinor)
+		fb.HighlightScale(scale)
+
+		tab, err := fb.Tab(TabOptions{NotesPerString: 3})
+
+		assert.NoError(t, err)
+		assert.Len(t, tab.Notes, 35)
+		assert.Equal(t, TabNote{String: 6, Fret: 5, Note: scale.Root}, tab.Notes[0])
+		assert.Equal(t, TabNote{String: 1, Fret: 10, Note: Note{letter: 'D'}}, tab.Notes[17])
+		assert.Equal(t, tab.Notes[0], tab.Notes[34])
+		assert.Equal(t, "E|-5--7--8-"+strings.Repeat("---", 29)+"-8--7--5--|", strings.Split(tab.String(), "\n")[5])
+	})
+
+	t.Run("play open strings and skip notes played on the string below", func(t *testing.T) {
+		fb, _ := New(Options{EndFret: 3})
+		scale, _ := NewScale("E", ScaleMinorPentatonic)
+		fb.HighlightScale(scale)
+
+		tab, err := fb.Tab(TabOptions{Direction: TabDirectionDown})
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"E|-3-0---------------------|",
+			"B|-----3-0-----------------|",
+			"G|---------2-0-------------|",
+			"D|-------------2-0---------|",
+			"A|-----------------2-0-----|",
+			"E|---------------------3-0-|",
+		}, "\n"), tab.String())
+	})
+
+	t.Run("return error without highlighted notes", func(t *testing.T) {
+		fb, _ := New(Options{})
+
+		_, err := fb.Tab(TabOptions{})
+
+		assert.Error(t, err)
+	})
+}
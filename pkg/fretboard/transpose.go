@@ -0,0 +1,123 @@
+package fretboard
+
+import "strings"
+
+// Transpose moves the note up by the given number of semitones, or down for negative numbers.
+// Flat notes stay flat, all other notes are spelled with sharps.
+func (n Note) Transpose(semitones int) Note {
+	if n.IsZero() {
+		return n
+	}
+
+	pitchClass := ((n.pitchClass()+semitones)%12 + 12) % 12
+	if n.accidental < 0 {
+		return flatNotes[pitchClass]
+	}
+	return sharpNotes[pitchClass]
+}
+
+// TransposeInterval moves the note up by the interval, keeping the spelling the interval asks
+// for: a minor third above A is C, a major third above Bb is D.
+func (n Note) TransposeInterval(i Interval) Note {
+	if n.IsZero() || i.Number == 0 {
+		return n
+	}
+
+	return n.atDegree(i.degree())
+}
+
+// Transpose moves the chord up by the given number of semitones, or down for negative numbers.
+// The new root is spelled so that the chord has fewer accidentals, e.g. Ab instead of G#.
+func (c Chord) Transpose(semitones int) Chord {
+	if c.Root.IsZero() {
+		return c
+	}
+
+	pitchClass := ((c.Root.pitchClass()+semitones)%12 + 12) % 12
+
+	var best Chord
+	for _, root := range []Note{sharpNotes[pitchClass], flatNotes[pitchClass]} {
+		t := c.TransposeInterval(c.Root.IntervalTo(root))
+		if best.Root.IsZero() || countAccidentals(t.Notes()) < countAccidentals(best.Notes()) {
+			best = t
+		}
+	}
+
+	return best
+}
+
+// TransposeInterval moves the chord up by the interval, see Note.TransposeInterval.
+func (c Chord) TransposeInterval(i Interval) Chord {
+	if c.Root.IsZero() || i.Number == 0 {
+		return c
+	}
+
+	suffix := strings.TrimPrefix(c.baseName(), c.Root.String())
+	t := newChord(c.Root.TransposeInterval(i), suffix, c.degrees)
+	return t.WithBass(c.Bass.TransposeInterval(i))
+}
+
+// Transpose moves the scale up by the given number of semitones, or down for negative numbers.
+// The new root is spelled as the key with fewer accidentals, e.g. Db major instead of C# major.
+func (s Scale) Transpose(semitones int) Scale {
+	if s.Root.IsZero() {
+		return s
+	}
+
+	pitchClass := ((s.Root.pitchClass()+semitones)%12 + 12) % 12
+
+	var best Scale
+	for _, root := range []Note{sharpNotes[pitchClass], flatNotes[pitchClass]} {
+		t := s.TransposeInterval(s.Root.IntervalTo(root))
+		if best.Root.IsZero() || countAccidentals(t.notes) < countAccidentals(best.notes) {
+			best = t
+		}
+	}
+
+	return best
+}
+
+// TransposeInterval moves the scale up by the interval, see Note.TransposeInterval.
+func (s Scale) TransposeInterval(i Interval) Scale {
+	if s.Root.IsZero() || i.Number == 0 {
+		return s
+	}
+
+	notes := make([]Note, len(s.notes))
+	for j, n := range s.notes {
+		notes[j] = n.TransposeInterval(i)
+	}
+
+	return Scale{Root: s.Root.TransposeInterval(i), notes: notes, scaleType: s.scaleType}
+}
+
+// Transpose moves the key and the chords of the progression up by the given number of
+// semitones, or down for negative numbers. The new key is spelled like in Scale.Transpose and
+// the chords are spelled in the new key, the numerals don't change.
+func (p Progression) Transpose(semitones int) Progression {
+	key := p.Key.Transpose(semitones)
+	return p.TransposeInterval(p.Key.Root.IntervalTo(key.Root))
+}
+
+// TransposeTo moves the progression into the key with the given root, e.g. from Bb to G.
+func (p Progression) TransposeTo(root Note) Progression {
+	return p.TransposeInterval(p.Key.Root.IntervalTo(root))
+}
+
+// TransposeInterval moves the key and the chords of the progression up by the interval.
+func (p Progression) TransposeInterval(i Interval) Progression {
+	t := Progression{Key: p.Key.TransposeInterval(i), Chords: make([]ProgressionChord, len(p.Chords))}
+	for j, c := range p.Chords {
+		c.Chord = c.Chord.TransposeInterval(i)
+		t.Chords[j] = c
+	}
+	return t
+}
+
+func countAccidentals(notes []Note) int {
+	accidentals := 0
+	for _, n := range notes {
+		accidentals += abs(n.accidental)
+	}
+	return accidentals
+}
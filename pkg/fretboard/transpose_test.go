@@ -0,0 +1,124 @@
+package fretboard
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNote_Transpose(t *testing.T) {
+	tests := []struct {
+		StartNote    string
+		Semitones    int
+		ExpectedNote string
+	}{
+		{StartNote: "A", Semitones: 0, ExpectedNote: "A"},
+		{StartNote: "A", Semitones: 3, ExpectedNote: "C"},
+		{StartNote: "A", Semitones: -1, ExpectedNote: "G#"},
+		{StartNote: "C", Semitones: -13, ExpectedNote: "B"},
+		{StartNote: "Bb", Semitones: 1, ExpectedNote: "B"},
+		{StartNote: "Bb", Semitones: -2, ExpectedNote: "Ab"},
+		{StartNote: "F#", Semitones: 24, ExpectedNote: "F#"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.StartNote, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedNote, mustParseNote(tt.StartNote).Transpose(tt.Semitones).String())
+		})
+	}
+}
+
+func TestNote_TransposeInterval(t *testing.T) {
+	majorSixth := Interval{Quality: QualityMajor, Number: 6}
+
+	assert.Equal(t, "G", mustParseNote("Bb").TransposeInterval(majorSixth).String())
+	assert.Equal(t, "C", mustParseNote("Eb").TransposeInterval(majorSixth).String())
+	assert.Equal(t, "D#", mustParseNote("F#").TransposeInterval(majorSixth).String())
+	assert.Equal(t, "Bb", mustParseNote("Bb").TransposeInterval(Interval{}).String())
+}
+
+func TestChord_Transpose(t *testing.T) {
+	t.Run("keep the chord type and spell the notes from the new root", func(t *testing.T) {
+		c := mustParseChord(t, "Am7b5").Transpose(-3)
+
+		assert.Equal(t, "F#min7b5", c.Name)
+		assert.Equal(t, "F# A C E", joinNoteNames(c.Notes()))
+	})
+
+	t.Run("move the bass note of a slash chord", func(t *testing.T) {
+		assert.Equal(t, "D/F#", mustParseChord(t, "C/E").Transpose(2).Name)
+		assert.Equal(t, "Ab/Bb", mustParseChord(t, "F/G").Transpose(3).Name)
+	})
+
+	t.Run("transpose by an interval", func(t *testing.T) {
+		c := mustParseChord(t, "Bb7").TransposeInterval(Interval{Quality: QualityMajor, Number: 6})
+
+		assert.Equal(t, "G7", c.Name)
+		assert.Equal(t, "G B D F", joinNoteNames(c.Notes()))
+	})
+}
+
+func TestScale_Transpose(t *testing.T) {
+	t.Run("spell the key with fewer accidentals", func(t *testing.T) {
+		cMajor, _ := NewScale("C", ScaleMajor)
+
+		assert.Equal(t, "Db major", cMajor.Transpose(1).Name())
+		assert.Equal(t, "Db Eb F Gb Ab Bb C", joinNoteNames(cMajor.Transpose(1).Notes()))
+		assert.Equal(t, "Bb major", cMajor.Transpose(-2).Name())
+		assert.Equal(t, "E major", cMajor.Transpose(4).Name())
+	})
+
+	t.Run("keep custom scales", func(t *testing.T) {
+		hijaz, _ := NewScaleFromFormula("E", "hijaz", "1 b2 3 4 5 b6 b7")
+
+		assert.Equal(t, "A hijaz", hijaz.Transpose(5).Name())
+		assert.Equal(t, "A Bb C# D E F G", joinNoteNames(hijaz.Transpose(5).Notes()))
+	})
+}
+
+func TestProgression_Transpose(t *testing.T) {
+	bbMajor, _ := NewScale("Bb", ScaleMajor)
+	p, err := ParseProgression(bbMajor, "I vi ii V7")
+	assert.NoError(t, err)
+
+	t.Run("move the progression to another key", func(t *testing.T) {
+		g := p.TransposeTo(mustParseNote("G"))
+
+		assert.Equal(t, "G major", g.Key.Name())
+		assert.Equal(t, []string{"G", "Emin", "Amin", "D7"}, progressionChordNames(g))
+		assert.Equal(t, "V7", g.Chords[3].Numeral)
+	})
+
+	t.Run("move the progression by semitones", func(t *testing.T) {
+		up := p.Transpose(1)
+
+		assert.Equal(t, "B major", up.Key.Name())
+		assert.Equal(t, []string{"B", "G#min", "C#min", "F#7"}, progressionChordNames(up))
+	})
+}
+
+func mustParseChord(t *testing.T, name string) Chord {
+	t.Helper()
+
+	c, err := ParseChord(name)
+	assert.NoError(t, err)
+	return c
+}
+
+func joinNoteNames(notes []Note) string {
+	names := ""
+	for i, n := range notes {
+		if i > 0 {
+			names += " "
+		}
+		names += n.String()
+	}
+	return names
+}
+
+func progressionChordNames(p Progression) []string {
+	names := make([]string, len(p.Chords))
+	for i, c := range p.Chords {
+		names[i] = c.Name
+	}
+	return names
+}
@@ -0,0 +1,187 @@
+package renderer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	FormatPNG  = "png"
+	FormatSVG  = "svg"
+	FormatPDF  = "pdf"
+	FormatText = "text"
+)
+
+var DefaultFormats = newDefaultFormatRegistry()
+
+// Format is an output format that can be chosen by its name, its MIME type or one of its file
+// extensions. New creates a Renderer drawing the given fretboard.
+type Format struct {
+	Name       string
+	MimeType   string
+	Extensions []string
+	New        func(fb *fretboard.Fretboard, options Options) Renderer
+}
+
+type FormatRegistry struct {
+	mu      sync.RWMutex
+	formats []Format
+	index   map[string]int
+}
+
+func NewFormatRegistry() *FormatRegistry {
+	return &FormatRegistry{index: make(map[string]int)}
+}
+
+func RegisterFormat(format Format) error {
+	return DefaultFormats.Register(format)
+}
+
+func (r *FormatRegistry) Register(format Format) error {
+	if strings.TrimSpace(format.Name) == "" {
+		return errors.New("name of a format must not be empty")
+	}
+	if format.New == nil {
+		return fmt.Errorf("format %s has no renderer", format.Name)
+	}
+	format.Extensions = append([]string{}, format.Extensions...)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := formatKeys(format)
+	for _, key := range keys {
+		if _, ok := r.index[key]; ok {
+			return fmt.Errorf("format %s is already registered", key)
+		}
+	}
+
+	r.formats = append(r.formats, format)
+	for _, key := range keys {
+		r.index[key] = len(r.formats) - 1
+	}
+
+	return nil
+}
+
+// Lookup finds a format by its name or its MIME type, e.g. "svg" or "image/svg+xml".
+func (r *FormatRegistry) Lookup(nameOrMimeType string) (Format, bool) {
+	key := formatKey(nameOrMimeType)
+	if strings.HasPrefix(key, ".") {
+		return Format{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.index[key]
+	if !ok {
+		return Format{}, false
+	}
+
+	return r.formats[i], true
+}
+
+// LookupFilename finds a format by the extension of the given file name.
+func (r *FormatRegistry) LookupFilename(filename string) (Format, bool) {
+	extension := formatKey(filepath.Ext(filename))
+	if extension == "" {
+		return Format{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.index[extension]
+	if !ok {
+		return Format{}, false
+	}
+
+	return r.formats[i], true
+}
+
+func (r *FormatRegistry) Formats() []Format {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	formats := make([]Format, len(r.formats))
+	copy(formats, r.formats)
+	return formats
+}
+
+// NewRenderer looks up a format like Lookup and creates its renderer.
+func (r *FormatRegistry) NewRenderer(format string, fb *fretboard.Fretboard, options Options) (Renderer, error) {
+	f, ok := r.Lookup(format)
+	if !ok {
+		return nil, fmt.Errorf("format %s is not supported", format)
+	}
+
+	return f.New(fb, options), nil
+}
+
+// formatKeys returns the keys a format is indexed by, extensions are stored with their leading
+// dot to keep them apart from the names.
+func formatKeys(format Format) []string {
+	keys := []string{formatKey(format.Name)}
+	if format.MimeType != "" {
+		keys = append(keys, formatKey(format.MimeType))
+	}
+	for _, extension := range format.Extensions {
+		keys = append(keys, "."+strings.TrimPrefix(formatKey(extension), "."))
+	}
+	return keys
+}
+
+func formatKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func newDefaultFormatRegistry() *FormatRegistry {
+	r := NewFormatRegistry()
+	formats := []Format{
+		{
+			Name:       FormatPNG,
+			MimeType:   "image/png",
+			Extensions: []string{".png"},
+			New: func(fb *fretboard.Fretboard, options Options) Renderer {
+				return NewPNGRenderer(fb, options)
+			},
+		},
+		{
+			Name:       FormatSVG,
+			MimeType:   "image/svg+xml",
+			Extensions: []string{".svg"},
+			New: func(fb *fretboard.Fretboard, options Options) Renderer {
+				return NewSVGRenderer(fb, options)
+			},
+		},
+		{
+			Name:       FormatPDF,
+			MimeType:   "application/pdf",
+			Extensions: []string{".pdf"},
+			New: func(fb *fretboard.Fretboard, options Options) Renderer {
+				return NewPDFRenderer(fb, options)
+			},
+		},
+		{
+			Name:       FormatText,
+			MimeType:   "text/plain",
+			Extensions: []string{".txt"},
+			New: func(fb *fretboard.Fretboard, options Options) Renderer {
+				return NewTextRenderer(fb, options)
+			},
+		},
+	}
+
+	for _, f := range formats {
+		if err := r.Register(f); err != nil {
+			panic(err)
+		}
+	}
+
+	return r
+}
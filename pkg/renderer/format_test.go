@@ -0,0 +1,94 @@
+package renderer
+
+import (
+	"bytes"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+type textRenderer struct{}
+
+func (textRenderer) Render(w io.Writer) error {
+	_, err := io.WriteString(w, "fretboard")
+	return err
+}
+
+func newTextRenderer(*fretboard.Fretboard, Options) Renderer {
+	return textRenderer{}
+}
+
+func TestFormatRegistry_Register(t *testing.T) {
+	t.Run("make a registered format available by name, mime type and extension", func(t *testing.T) {
+		r := NewFormatRegistry()
+		err := r.Register(Format{Name: "text", MimeType: "text/plain", Extensions: []string{"txt"}, New: newTextRenderer})
+		assert.NoError(t, err)
+
+		byName, ok := r.Lookup("Text")
+		assert.True(t, ok)
+		byMimeType, ok := r.Lookup("text/plain")
+		assert.True(t, ok)
+		byFilename, ok := r.LookupFilename("scale.TXT")
+		assert.True(t, ok)
+		assert.Equal(t, "text", byName.Name)
+		assert.Equal(t, "text", byMimeType.Name)
+		assert.Equal(t, "text", byFilename.Name)
+
+		_, ok = r.Lookup("txt")
+		assert.False(t, ok)
+	})
+
+	t.Run("return error for an invalid format", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			Format Format
+		}{
+			{Name: "empty name", Format: Format{New: newTextRenderer}},
+			{Name: "missing renderer", Format: Format{Name: "text"}},
+			{Name: "name already registered", Format: Format{Name: "PNG", New: newTextRenderer}},
+			{Name: "mime type already registered", Format: Format{Name: "other", MimeType: "image/svg+xml", New: newTextRenderer}},
+			{Name: "extension already registered", Format: Format{Name: "other", Extensions: []string{".png"}, New: newTextRenderer}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				r := newDefaultFormatRegistry()
+				assert.Error(t, r.Register(tt.Format))
+			})
+		}
+	})
+}
+
+func TestFormatRegistry_NewRenderer(t *testing.T) {
+	fb, _ := fretboard.New(fretboard.Options{})
+
+	t.Run("render the fretboard in the chosen format", func(t *testing.T) {
+		tests := []struct {
+			Format         string
+			ExpectedPrefix string
+		}{
+			{Format: "png", ExpectedPrefix: "\x89PNG"},
+			{Format: "image/png", ExpectedPrefix: "\x89PNG"},
+			{Format: "svg", ExpectedPrefix: "<svg"},
+			{Format: "image/svg+xml", ExpectedPrefix: "<svg"},
+			{Format: "pdf", ExpectedPrefix: "%PDF-"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Format, func(t *testing.T) {
+				r, err := DefaultFormats.NewRenderer(tt.Format, fb, Options{})
+				assert.NoError(t, err)
+
+				var buf bytes.Buffer
+				assert.NoError(t, r.Render(&buf))
+				assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte(tt.ExpectedPrefix)))
+			})
+		}
+	})
+
+	t.Run("return error for an unknown format", func(t *testing.T) {
+		_, err := DefaultFormats.NewRenderer("gif", fb, Options{})
+		assert.Error(t, err)
+	})
+}
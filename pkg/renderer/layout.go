@@ -0,0 +1,215 @@
+package renderer
+
+import (
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+)
+
+const (
+	stringSpacing       = 30.0
+	fretSpacing         = 60.0
+	extraSpaceHeadstock = 30.0
+	noteRadius          = 10.0
+)
+
+// layout places the parts of a fretboard on a canvas, so that every graphical Renderer draws
+// the same picture. The neck is drawn with the headstock on the right and string 1 at the top.
+type layout struct {
+	fb            *fretboard.Fretboard
+	options       Options
+	width         float64
+	height        float64
+	stringSpacing float64
+	fretSpacing   float64
+}
+
+// notePosition is a note to be drawn on the fretboard with its label and role.
+type notePosition struct {
+	Fret   fretboard.Fret
+	String uint
+	X      float64
+	Y      float64
+	Label  string
+	Role   noteRole
+}
+
+// capoBar is the rectangle of a full or partial capo.
+type capoBar struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+func newLayout(fb *fretboard.Fretboard, options Options) layout {
+	fbWidth := float64(fb.EndFret-fb.StartFret+1) * fretSpacing
+	fbHeight := float64(fb.Strings) * stringSpacing
+
+	return layout{
+		fb:            fb,
+		options:       options,
+		width:         2*options.FretboardOffsetX + fbWidth + extraSpaceHeadstock,
+		height:        2*options.FretboardOffsetY + fbHeight,
+		stringSpacing: stringSpacing,
+		fretSpacing:   fretSpacing,
+	}
+}
+
+// wireX returns the x coordinate of the fret wire between the given fret and the next one, the
+// nut being fret 0.
+func (l layout) wireX(fret uint) float64 {
+	return l.options.FretboardOffsetX + (float64(l.fb.EndFret)-float64(fret))*l.fretSpacing
+}
+
+// noteX returns the x coordinate of the notes played on the given fret.
+func (l layout) noteX(fret uint) float64 {
+	return l.wireX(fret) + 0.5*l.fretSpacing
+}
+
+func (l layout) stringY(str uint) float64 {
+	return l.options.FretboardOffsetY + float64(str)*l.stringSpacing
+}
+
+func (l layout) titlePosition() (float64, float64) {
+	return l.options.FretboardOffsetX, 0.75 * l.options.FretboardOffsetY
+}
+
+func (l layout) fretNumberY() float64 {
+	return l.stringY(l.fb.Strings) + 0.75*l.stringSpacing
+}
+
+// headstockEndX returns the x coordinate where the outline of the headstock ends. The outline
+// starts at the nut on the outer strings and spreads by 20 units.
+func (l layout) headstockEndX() float64 {
+	return l.width - l.options.FretboardOffsetX
+}
+
+// startFretPosition returns where the number of the first fret is written if the nut isn't shown.
+func (l layout) startFretPosition() (float64, float64) {
+	return l.wireX(l.fb.StartFret-1) + 5, 0.5 * (l.stringY(1) + l.stringY(l.fb.Strings))
+}
+
+// capoBars returns a bar across all strings for the full capo and a shorter bar for every
+// group of neighbouring strings clamped at the same fret by a partial capo. Bars outside of the
+// shown frets are left out.
+func (l layout) capoBars() []capoBar {
+	var bars []capoBar
+	if l.fb.Capo > 0 {
+		bars = l.appendCapoBar(bars, l.fb.Capo, 1, l.fb.Strings)
+	}
+
+	for first := uint(1); first <= l.fb.Strings; first++ {
+		fret := l.fb.CapoFret(first)
+		if fret <= l.fb.Capo {
+			continue
+		}
+
+		last := first
+		for last < l.fb.Strings && l.fb.CapoFret(last+1) == fret {
+			last++
+		}
+		bars = l.appendCapoBar(bars, fret, first, last)
+		first = last
+	}
+
+	return bars
+}
+
+func (l layout) appendCapoBar(bars []capoBar, fret uint, firstString uint, lastString uint) []capoBar {
+	if fret < l.fb.StartFret || fret > l.fb.EndFret {
+		return bars
+	}
+
+	top := l.stringY(firstString) - 0.5*l.stringSpacing
+	bottom := l.stringY(lastString) + 0.5*l.stringSpacing
+	return append(bars, capoBar{
+		X:      l.noteX(fret) - 0.2*l.fretSpacing,
+		Y:      top,
+		Width:  0.4 * l.fretSpacing,
+		Height: bottom - top,
+	})
+}
+
+// notes returns every note that is drawn: the open strings at the nut, the highlighted frets and
+// the frets a capo sits on. Open strings are highlighted like every other fret, the others are
+// labelled with the names of the strings unless they are hidden.
+func (l layout) notes() ([]notePosition, error) {
+	bassString, err := l.bassString()
+	if err != nil {
+		return nil, err
+	}
+
+	var notes []notePosition
+	for s := uint(1); s <= l.fb.Strings; s++ {
+		if !l.fb.ShowsNut() {
+			continue
+		}
+
+		fret, err := l.fb.Fret(s, 0)
+		if err != nil {
+			return nil, err
+		}
+		if !fret.Highlighted && l.options.HideOutOfScaleOpenStrings {
+			continue
+		}
+
+		n := l.notePosition(fret, s, l.wireX(0), s == bassString)
+		if !fret.Highlighted {
+			n.Label = fret.Note.String()
+		}
+		notes = append(notes, n)
+	}
+
+	for s := uint(1); s <= l.fb.Strings; s++ {
+		for f := int(l.fb.EndFret); f >= int(l.fb.StartFret); f-- {
+			fret, err := l.fb.Fret(s, uint(f))
+			if err != nil {
+				return nil, err
+			}
+			if !fret.Highlighted && (uint(f) != l.fb.CapoFret(s) || l.options.HideOutOfScaleOpenStrings) {
+				continue
+			}
+
+			notes = append(notes, l.notePosition(fret, s, l.noteX(uint(f)), s == bassString))
+		}
+	}
+
+	return notes, nil
+}
+
+// bassString returns the lowest string the bass of a slash chord can be played on within the
+// shown frets, or 0 if the chord has no bass note or it can't be played.
+func (l layout) bassString() (uint, error) {
+	if l.fb.Chord.Bass.IsZero() {
+		return 0, nil
+	}
+
+	first := l.fb.StartFret
+	if l.fb.ShowsNut() {
+		first = 0
+	}
+
+	for s := l.fb.Strings; s >= 1; s-- {
+		for f := first; f <= l.fb.EndFret; f++ {
+			fret, err := l.fb.Fret(s, f)
+			if err != nil {
+				return 0, err
+			}
+			if fret.Highlighted && fret.Note.Equals(l.fb.Chord.Bass) {
+				return s, nil
+			}
+		}
+	}
+
+	return 0, nil
+}
+
+func (l layout) notePosition(fret fretboard.Fret, str uint, x float64, bassString bool) notePosition {
+	return notePosition{
+		Fret:   fret,
+		String: str,
+		X:      x,
+		Y:      l.stringY(str),
+		Label:  noteLabel(l.fb, l.options.TextDisplayMode, str, fret.Note),
+		Role:   roleOf(l.fb, fret.Note, fret.Highlighted, bassString),
+	}
+}
@@ -0,0 +1,137 @@
+package renderer
+
+import (
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLayout_Notes(t *testing.T) {
+	t.Run("colour the bass of a slash chord only on the lowest string it can be played on", func(t *testing.T) {
+		tests := []struct {
+			Chord        string
+			StartFret    uint
+			EndFret      uint
+			ExpectedBass []notePositionKey
+		}{
+			{Chord: "C/E", EndFret: 12, ExpectedBass: []notePositionKey{{String: 6, Fret: 0}, {String: 6, Fret: 12}}},
+			{Chord: "C/E", StartFret: 5, EndFret: 8, ExpectedBass: []notePositionKey{{String: 5, Fret: 7}}},
+			{Chord: "C/Bb", EndFret: 12, ExpectedBass: []notePositionKey{{String: 6, Fret: 6}}},
+			{Chord: "C", EndFret: 12, ExpectedBass: nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Chord, func(t *testing.T) {
+				fb, _ := fretboard.New(fretboard.Options{StartFret: tt.StartFret, EndFret: tt.EndFret})
+				scale, _ := fretboard.NewScale("C", fretboard.ScaleMajor)
+				chord, _ := fretboard.ParseChord(tt.Chord)
+				fb.HighlightScale(scale)
+				fb.HighlightChord(chord)
+
+				notes, err := newLayout(fb, Options{}).notes()
+				assert.NoError(t, err)
+
+				var bass []notePositionKey
+				for _, n := range notes {
+					if n.Role == noteRoleBass {
+						bass = append(bass, notePositionKey{String: n.String, Fret: n.Fret.Number})
+					}
+				}
+				assert.ElementsMatch(t, tt.ExpectedBass, bass)
+			})
+		}
+	})
+
+	t.Run("colour the bass like a chord tone on the other strings", func(t *testing.T) {
+		fb, _ := fretboard.New(fretboard.Options{})
+		scale, _ := fretboard.NewScale("C", fretboard.ScaleMajor)
+		chord, _ := fretboard.ParseChord("C/Bb")
+		fb.HighlightScale(scale)
+		fb.HighlightChord(chord)
+
+		notes, err := newLayout(fb, Options{}).notes()
+		assert.NoError(t, err)
+
+		roles := rolesByPosition(notes)
+		assert.Equal(t, noteRoleBass, roles[notePositionKey{String: 6, Fret: 6}])
+		assert.Equal(t, noteRoleChord, roles[notePositionKey{String: 5, Fret: 1}])
+	})
+
+	t.Run("label shapes relative to the capo of every string", func(t *testing.T) {
+		fb, _ := fretboard.New(fretboard.Options{StringCapos: []uint{0, 0, 2, 2, 2, 0}})
+		scale, _ := fretboard.NewScale("A", fretboard.ScaleMajor)
+		fb.HighlightScale(scale)
+
+		notes, err := newLayout(fb, Options{TextDisplayMode: TextDisplayModeShapeRelativeToCapo}).notes()
+		assert.NoError(t, err)
+
+		labels := make(map[notePositionKey]string, len(notes))
+		for _, n := range notes {
+			labels[notePositionKey{String: n.String, Fret: n.Fret.Number}] = n.Label
+		}
+		assert.Equal(t, "G", labels[notePositionKey{String: 3, Fret: 2}])
+		assert.Equal(t, "A", labels[notePositionKey{String: 5, Fret: 0}])
+	})
+
+	t.Run("draw the open strings and the strings at the capo that aren't highlighted unless they are hidden", func(t *testing.T) {
+		tests := []struct {
+			Name         string
+			Capo         uint
+			Hide         bool
+			ExpectedOpen map[uint]noteRole
+		}{
+			{
+				Name:         "show out of scale open strings",
+				ExpectedOpen: map[uint]noteRole{1: noteRoleScale, 2: noteRoleMisc, 3: noteRoleScale, 4: noteRoleScale, 5: noteRoleScale, 6: noteRoleScale},
+			},
+			{
+				Name:         "hide out of scale open strings",
+				Hide:         true,
+				ExpectedOpen: map[uint]noteRole{1: noteRoleScale, 3: noteRoleScale, 4: noteRoleScale, 5: noteRoleScale, 6: noteRoleScale},
+			},
+			{
+				Name:         "show out of scale strings at the capo",
+				Capo:         2,
+				ExpectedOpen: map[uint]noteRole{1: noteRoleMisc, 2: noteRoleMisc, 3: noteRoleScale, 4: noteRoleScale, 5: noteRoleMisc, 6: noteRoleMisc},
+			},
+			{
+				Name:         "hide out of scale strings at the capo",
+				Capo:         2,
+				Hide:         true,
+				ExpectedOpen: map[uint]noteRole{3: noteRoleScale, 4: noteRoleScale},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				fb, _ := fretboard.New(fretboard.Options{EndFret: 3, Capo: tt.Capo})
+				scale, _ := fretboard.NewScale("C", fretboard.ScaleMajorPentatonic)
+				fb.HighlightScale(scale)
+
+				notes, err := newLayout(fb, Options{HideOutOfScaleOpenStrings: tt.Hide}).notes()
+				assert.NoError(t, err)
+
+				open := make(map[uint]noteRole)
+				for _, n := range notes {
+					if n.Fret.Number == tt.Capo {
+						open[n.String] = n.Role
+					}
+				}
+				assert.Equal(t, tt.ExpectedOpen, open)
+			})
+		}
+	})
+}
+
+type notePositionKey struct {
+	String uint
+	Fret   uint
+}
+
+func rolesByPosition(notes []notePosition) map[notePositionKey]noteRole {
+	roles := make(map[notePositionKey]noteRole, len(notes))
+	for _, n := range notes {
+		roles[notePositionKey{String: n.String, Fret: n.Fret.Number}] = n.Role
+	}
+	return roles
+}
@@ -0,0 +1,226 @@
+package renderer
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"strings"
+)
+
+const (
+	pdfFontRegular = "F1"
+	pdfFontBold    = "F2"
+	// pdfCircleKappa places the control points of the four Bézier curves approximating a circle.
+	pdfCircleKappa = 0.5523
+)
+
+// helveticaWidths are the widths of the printable ASCII characters in the Helvetica font, in
+// thousandths of the font size, starting at the space.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// pdfDocument writes a PDF with the standard Helvetica fonts, which every PDF reader has to
+// provide, so no font has to be embedded. Pages are drawn with coordinates in points from the
+// top left corner like the other renderers, they are flipped when the page is written.
+type pdfDocument struct {
+	title  string
+	width  float64
+	height float64
+	pages  []*pdfPage
+}
+
+type pdfPage struct {
+	content bytes.Buffer
+	height  float64
+}
+
+func newPDFDocument(title string, width, height float64) *pdfDocument {
+	return &pdfDocument{title: title, width: width, height: height}
+}
+
+func (d *pdfDocument) addPage() *pdfPage {
+	p := &pdfPage{height: d.height}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// write numbers the objects as follows: 1 is the catalog, 2 the page tree, 3 and 4 the fonts
+// and 5 the document information, followed by a page and its content for every page.
+func (d *pdfDocument) write(w io.Writer) error {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Title %s /Producer (scalemate) >>", pdfString(d.title)),
+	}
+
+	kids := make([]string, len(d.pages))
+	for i, p := range d.pages {
+		pageObject := len(objects) + 1
+		kids[i] = fmt.Sprintf("%d 0 R", pageObject)
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		if _, err := zw.Write(p.content.Bytes()); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+				pdfNumber(d.width), pdfNumber(d.height), pdfFontRegular, pdfFontBold, pageObject+1),
+			fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.String()),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, o := range objects {
+		offsets[i] = buf.Len()
+		_, _ = fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+
+	xref := buf.Len()
+	_, _ = fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		_, _ = fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	_, _ = fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+func (p *pdfPage) printf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(&p.content, format, args...)
+}
+
+func (p *pdfPage) setFillColor(c color.RGBA) {
+	p.printf("%s %s %s rg\n", pdfColor(c.R), pdfColor(c.G), pdfColor(c.B))
+}
+
+func (p *pdfPage) setStrokeColor(c color.RGBA) {
+	p.printf("%s %s %s RG\n", pdfColor(c.R), pdfColor(c.G), pdfColor(c.B))
+}
+
+func (p *pdfPage) setLineWidth(width float64) {
+	p.printf("%s w\n", pdfNumber(width))
+}
+
+func (p *pdfPage) line(x1, y1, x2, y2 float64) {
+	p.printf("%s %s m %s %s l S\n", pdfNumber(x1), pdfNumber(p.height-y1), pdfNumber(x2), pdfNumber(p.height-y2))
+}
+
+func (p *pdfPage) fillRect(x, y, width, height float64) {
+	p.printf("%s %s %s %s re f\n", pdfNumber(x), pdfNumber(p.height-y-height), pdfNumber(width), pdfNumber(height))
+}
+
+// fillRoundedRect fills a rectangle whose corners are rounded with the given radius.
+func (p *pdfPage) fillRoundedRect(x, y, width, height, radius float64) {
+	radius = math.Min(radius, math.Min(width, height)/2)
+	k := radius * pdfCircleKappa
+	left, right := x, x+width
+	top, bottom := p.height-y, p.height-y-height
+
+	p.printf("%s %s m\n", pdfNumber(left+radius), pdfNumber(top))
+	p.printf("%s %s l\n", pdfNumber(right-radius), pdfNumber(top))
+	p.curve(right-radius+k, top, right, top-radius+k, right, top-radius)
+	p.printf("%s %s l\n", pdfNumber(right), pdfNumber(bottom+radius))
+	p.curve(right, bottom+radius-k, right-radius+k, bottom, right-radius, bottom)
+	p.printf("%s %s l\n", pdfNumber(left+radius), pdfNumber(bottom))
+	p.curve(left+radius-k, bottom, left, bottom+radius-k, left, bottom+radius)
+	p.printf("%s %s l\n", pdfNumber(left), pdfNumber(top-radius))
+	p.curve(left, top-radius+k, left+radius-k, top, left+radius, top)
+	p.printf("f\n")
+}
+
+func (p *pdfPage) fillCircle(x, y, radius float64) {
+	p.fillRoundedRect(x-radius, y-radius, 2*radius, 2*radius, radius)
+}
+
+func (p *pdfPage) curve(x1, y1, x2, y2, x3, y3 float64) {
+	p.printf("%s %s %s %s %s %s c\n", pdfNumber(x1), pdfNumber(y1), pdfNumber(x2), pdfNumber(y2), pdfNumber(x3), pdfNumber(y3))
+}
+
+// text writes the text with its baseline at y. anchorX moves the text to the left by the given
+// part of its width, 0.5 centers it on x.
+func (p *pdfPage) text(font string, size float64, x, y, anchorX float64, text string) {
+	x -= anchorX * textWidth(text, size)
+	p.printf("BT /%s %s Tf %s %s Td %s Tj ET\n", font, pdfNumber(size), pdfNumber(x), pdfNumber(p.height-y), pdfString(text))
+}
+
+// textCentered writes the text centered on x and y like gg.Context.DrawStringAnchored.
+func (p *pdfPage) textCentered(font string, size float64, x, y float64, text string) {
+	p.text(font, size, x, y+0.35*size, 0.5, text)
+}
+
+// textWidth returns the width of the text in the regular Helvetica font. The bold font is
+// slightly wider, but it is only used for text that isn't centered.
+func textWidth(text string, size float64) float64 {
+	width := 0
+	for _, r := range text {
+		switch {
+		case r >= ' ' && r <= '~':
+			width += helveticaWidths[r-' ']
+		case r == '°':
+			width += 400
+		default:
+			width += 556
+		}
+	}
+	return float64(width) * size / 1000
+}
+
+// pdfString writes the text as a PDF string in the WinAnsi encoding of the fonts. Latin-1
+// characters are kept, sharp and flat signs are written as "#" and "b" and everything else is
+// replaced by a question mark.
+func pdfString(text string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= ' ' && r <= '~':
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		case r == '♯':
+			b.WriteByte('#')
+		case r == '♭':
+			b.WriteByte('b')
+		default:
+			b.WriteByte('?')
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+func pdfNumber(n float64) string {
+	s := strings.TrimRight(fmt.Sprintf("%.2f", n), "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+func pdfColor(c uint8) string {
+	return pdfNumber(float64(c) / 255)
+}
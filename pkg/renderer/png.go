@@ -0,0 +1,140 @@
+package renderer
+
+import (
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/colornames"
+	"golang.org/x/image/font/gofont/goregular"
+	"image/png"
+	"io"
+	"strconv"
+)
+
+type PNGRenderer struct {
+	dc     *gg.Context
+	fb     *fretboard.Fretboard
+	layout layout
+	width  int
+	height int
+	font   *truetype.Font
+}
+
+func NewPNGRenderer(fretboard *fretboard.Fretboard, options Options) PNGRenderer {
+	l := newLayout(fretboard, options)
+	width, height := int(l.width), int(l.height)
+
+	return PNGRenderer{
+		dc:     gg.NewContext(width, height),
+		fb:     fretboard,
+		layout: l,
+		width:  width,
+		height: height,
+	}
+}
+
+func (p PNGRenderer) Render(w io.Writer) error {
+	f, err := truetype.Parse(goregular.TTF)
+	if err != nil {
+		return err
+	}
+	p.font = f
+
+	err = p.drawFretboard()
+	if err != nil {
+		return err
+	}
+
+	return png.Encode(w, p.dc.Image())
+}
+
+func (p PNGRenderer) drawFretboard() error {
+	notes, err := p.layout.notes()
+	if err != nil {
+		return err
+	}
+
+	p.fillBackground()
+
+	if p.layout.options.DrawTitle {
+		p.drawTitle()
+	}
+	p.drawNeck()
+	p.drawCapo()
+	if !p.fb.ShowsNut() {
+		p.drawStartFret()
+	}
+
+	for _, n := range notes {
+		p.drawNote(n)
+	}
+
+	return nil
+}
+
+func (p PNGRenderer) fillBackground() {
+	p.dc.SetColor(colornames.White)
+	p.dc.DrawRectangle(0, 0, float64(p.width), float64(p.height))
+	p.dc.Fill()
+
+	p.dc.SetColor(colornames.Black)
+}
+
+func (p PNGRenderer) drawTitle() {
+	p.dc.SetFontFace(truetype.NewFace(p.font, &truetype.Options{Size: 20}))
+	x, y := p.layout.titlePosition()
+	p.dc.DrawString(title(p.fb, p.layout.options), x, y)
+	p.dc.SetFontFace(truetype.NewFace(p.font, &truetype.Options{Size: 12}))
+}
+
+func (p PNGRenderer) drawNeck() {
+	l := p.layout
+	for str := uint(1); str <= p.fb.Strings; str++ {
+		p.dc.DrawLine(l.wireX(p.fb.EndFret), l.stringY(str), l.wireX(p.fb.StartFret-1), l.stringY(str))
+	}
+
+	top, bottom := l.stringY(1), l.stringY(p.fb.Strings)
+	for fret := p.fb.StartFret - 1; fret <= p.fb.EndFret; fret++ {
+		p.dc.DrawLine(l.wireX(fret), top, l.wireX(fret), bottom)
+
+		if fret >= p.fb.StartFret {
+			p.dc.DrawStringAnchored(strconv.Itoa(int(fret)), l.noteX(fret), l.fretNumberY(), 0.5, 0.5)
+		}
+	}
+
+	if p.fb.ShowsNut() {
+		p.dc.DrawLine(l.wireX(0), top, l.headstockEndX(), top-20)
+		p.dc.DrawLine(l.wireX(0), bottom, l.headstockEndX(), bottom+20)
+	}
+
+	p.dc.Stroke()
+}
+
+// drawStartFret labels the first shown fret next to the neck if the nut isn't visible, like
+// "5fr" in a position diagram.
+func (p PNGRenderer) drawStartFret() {
+	x, y := p.layout.startFretPosition()
+	p.dc.DrawStringAnchored(fmt.Sprintf("%dfr", p.fb.StartFret), x, y, 0, 0.5)
+}
+
+// drawCapo draws the bars of the full and the partial capos. The notes of the capo frets are
+// drawn on top of them like the open strings at the nut.
+func (p PNGRenderer) drawCapo() {
+	for _, bar := range p.layout.capoBars() {
+		p.dc.SetColor(colorCapo)
+		p.dc.DrawRoundedRectangle(bar.X, bar.Y, bar.Width, bar.Height, 5)
+		p.dc.Fill()
+		p.dc.SetColor(colornames.Black)
+	}
+}
+
+func (p PNGRenderer) drawNote(n notePosition) {
+	p.dc.SetColor(roleColors[n.Role])
+	p.dc.DrawCircle(n.X, n.Y, noteRadius)
+	p.dc.Fill()
+
+	p.dc.SetColor(colornames.White)
+	p.dc.DrawStringAnchored(n.Label, n.X, n.Y-2, 0.5, 0.5)
+	p.dc.Stroke()
+}
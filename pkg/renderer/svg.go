@@ -0,0 +1,129 @@
+package renderer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"image/color"
+	"io"
+)
+
+// SVGRenderer draws the same picture as the PNGRenderer as a vector graphic. Text stays
+// selectable and every note carries its role (root, bass, chord, scale or misc) as a CSS class,
+// so the colours can be changed with a stylesheet.
+type SVGRenderer struct {
+	buf    *bytes.Buffer
+	fb     *fretboard.Fretboard
+	layout layout
+}
+
+// NewSVGRenderer takes the same options as NewPNGRenderer.
+func NewSVGRenderer(fretboard *fretboard.Fretboard, options Options) SVGRenderer {
+	return SVGRenderer{
+		buf:    &bytes.Buffer{},
+		fb:     fretboard,
+		layout: newLayout(fretboard, options),
+	}
+}
+
+func (s SVGRenderer) Render(w io.Writer) error {
+	notes, err := s.layout.notes()
+	if err != nil {
+		return err
+	}
+
+	width, height := s.layout.width, s.layout.height
+	s.buf.Reset()
+	s.printf(`<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%g" viewBox="0 0 %g %g" font-family="sans-serif">`+"\n", width, height, width, height)
+	s.drawStyle()
+	s.printf(`<rect class="background" width="%g" height="%g"/>`+"\n", width, height)
+
+	if s.layout.options.DrawTitle {
+		s.drawTitle()
+	}
+	s.drawNeck()
+	s.drawCapo()
+
+	s.printf(`<g class="notes">` + "\n")
+	for _, n := range notes {
+		s.drawNote(n)
+	}
+	s.printf("</g>\n</svg>\n")
+
+	_, err = s.buf.WriteTo(w)
+	return err
+}
+
+func (s SVGRenderer) drawStyle() {
+	s.printf("<style>\n")
+	s.printf(".background { fill: #ffffff; }\n")
+	s.printf(".neck line { stroke: #000000; stroke-width: 1; }\n")
+	s.printf(".title { font-size: 20px; }\n")
+	s.printf(".fret-number, .start-fret { font-size: 12px; }\n")
+	s.printf(".capo { fill: %s; }\n", hexColor(colorCapo))
+	s.printf(".note text { fill: #ffffff; font-size: 12px; }\n")
+	for _, role := range []noteRole{noteRoleRoot, noteRoleBass, noteRoleChord, noteRoleScale, noteRoleMisc} {
+		s.printf(".note.%s circle { fill: %s; }\n", role, hexColor(roleColors[role]))
+	}
+	s.printf("</style>\n")
+}
+
+func (s SVGRenderer) drawTitle() {
+	x, y := s.layout.titlePosition()
+	s.printf(`<text class="title" x="%g" y="%g">%s</text>`+"\n", x, y, escapeText(title(s.fb, s.layout.options)))
+}
+
+func (s SVGRenderer) drawNeck() {
+	l := s.layout
+	s.printf(`<g class="neck">` + "\n")
+	for str := uint(1); str <= s.fb.Strings; str++ {
+		s.printf(`<line x1="%g" y1="%g" x2="%g" y2="%g"/>`+"\n", l.wireX(s.fb.EndFret), l.stringY(str), l.wireX(s.fb.StartFret-1), l.stringY(str))
+	}
+
+	top, bottom := l.stringY(1), l.stringY(s.fb.Strings)
+	for fret := s.fb.StartFret - 1; fret <= s.fb.EndFret; fret++ {
+		s.printf(`<line x1="%g" y1="%g" x2="%g" y2="%g"/>`+"\n", l.wireX(fret), top, l.wireX(fret), bottom)
+	}
+
+	if s.fb.ShowsNut() {
+		s.printf(`<line x1="%g" y1="%g" x2="%g" y2="%g"/>`+"\n", l.wireX(0), top, l.headstockEndX(), top-20)
+		s.printf(`<line x1="%g" y1="%g" x2="%g" y2="%g"/>`+"\n", l.wireX(0), bottom, l.headstockEndX(), bottom+20)
+	}
+	s.printf("</g>\n")
+
+	for fret := s.fb.StartFret; fret <= s.fb.EndFret; fret++ {
+		s.printf(`<text class="fret-number" x="%g" y="%g" text-anchor="middle" dominant-baseline="central">%d</text>`+"\n", l.noteX(fret), l.fretNumberY(), fret)
+	}
+	if !s.fb.ShowsNut() {
+		x, y := l.startFretPosition()
+		s.printf(`<text class="start-fret" x="%g" y="%g" dominant-baseline="central">%dfr</text>`+"\n", x, y, s.fb.StartFret)
+	}
+}
+
+func (s SVGRenderer) drawCapo() {
+	for _, bar := range s.layout.capoBars() {
+		s.printf(`<rect class="capo" x="%g" y="%g" width="%g" height="%g" rx="5"/>`+"\n", bar.X, bar.Y, bar.Width, bar.Height)
+	}
+}
+
+func (s SVGRenderer) drawNote(n notePosition) {
+	s.printf(`<g class="note %s" data-string="%d" data-fret="%d" data-note="%s">`, n.Role, n.String, n.Fret.Number, n.Fret.Note)
+	s.printf(`<circle cx="%g" cy="%g" r="%g"/>`, n.X, n.Y, noteRadius)
+	s.printf(`<text x="%g" y="%g" text-anchor="middle" dominant-baseline="central">%s</text>`, n.X, n.Y, escapeText(n.Label))
+	s.printf("</g>\n")
+}
+
+func (s SVGRenderer) printf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(s.buf, format, args...)
+}
+
+func escapeText(text string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(text))
+	return buf.String()
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -0,0 +1,58 @@
+package renderer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestSVGRenderer_Render(t *testing.T) {
+	render := func(t *testing.T, options Options) string {
+		fb, _ := fretboard.New(fretboard.Options{EndFret: 5})
+		scale, _ := fretboard.NewScale("C", fretboard.ScaleMajor)
+		chord, _ := fretboard.ParseChord("G7")
+		fb.HighlightScale(scale)
+		fb.HighlightChord(chord)
+
+		var buf bytes.Buffer
+		err := NewSVGRenderer(fb, options).Render(&buf)
+		assert.NoError(t, err)
+		return buf.String()
+	}
+
+	t.Run("write a well-formed document", func(t *testing.T) {
+		decoder := xml.NewDecoder(bytes.NewBufferString(render(t, Options{DrawTitle: true})))
+
+		var err error
+		for err == nil {
+			_, err = decoder.Token()
+		}
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("mark every note with its role, string, fret and name", func(t *testing.T) {
+		svg := render(t, Options{})
+
+		assert.Contains(t, svg, `<g class="note root" data-string="5" data-fret="3" data-note="C">`)
+		assert.Contains(t, svg, `<g class="note chord" data-string="6" data-fret="3" data-note="G">`)
+		assert.Contains(t, svg, `<g class="note chord" data-string="4" data-fret="3" data-note="F">`)
+		assert.Contains(t, svg, `<g class="note scale" data-string="4" data-fret="2" data-note="E">`)
+		assert.NotContains(t, svg, `class="note misc"`)
+	})
+
+	t.Run("escape the title", func(t *testing.T) {
+		svg := render(t, Options{DrawTitle: true, Title: "Tom & Jerry <live>"})
+
+		assert.Contains(t, svg, `>Tom &amp; Jerry &lt;live&gt;</text>`)
+		assert.NotContains(t, svg, "Tom & Jerry")
+	})
+
+	t.Run("leave out the title unless it is drawn", func(t *testing.T) {
+		svg := render(t, Options{Title: "Tom & Jerry"})
+
+		assert.NotContains(t, svg, `class="title"`)
+	})
+}
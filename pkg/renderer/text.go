@@ -0,0 +1,125 @@
+package renderer
+
+import (
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"image/color"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	textFretWidth = 5
+	ansiReset     = "\x1b[0m"
+)
+
+var ansiEscapes = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// TextRenderer draws the fretboard with box-drawing characters for terminals, oriented like the
+// pictures with the headstock on the right and string 1 at the top. The nut is drawn as a double
+// line followed by the open strings, a capo fills the frets it clamps with blocks. With
+// Options.ANSIColors the notes are coloured like the circles in the pictures.
+type TextRenderer struct {
+	fb     *fretboard.Fretboard
+	layout layout
+}
+
+func NewTextRenderer(fretboard *fretboard.Fretboard, options Options) TextRenderer {
+	return TextRenderer{fb: fretboard, layout: newLayout(fretboard, options)}
+}
+
+func (t TextRenderer) Render(w io.Writer) error {
+	notes, err := t.layout.notes()
+	if err != nil {
+		return err
+	}
+
+	cells := make(map[[2]uint]notePosition, len(notes))
+	for _, n := range notes {
+		cells[[2]uint{n.String, n.Fret.Number}] = n
+	}
+
+	var b strings.Builder
+	if t.layout.options.DrawTitle {
+		b.WriteString(title(t.fb, t.layout.options) + "\n\n")
+	}
+
+	for str := uint(1); str <= t.fb.Strings; str++ {
+		b.WriteString(t.wire(str, t.fb.EndFret))
+		for fret := t.fb.EndFret; fret >= t.fb.StartFret; fret-- {
+			b.WriteString(t.fret(str, fret, cells))
+			b.WriteString(t.wire(str, fret-1))
+		}
+		if t.fb.ShowsNut() {
+			if n, ok := cells[[2]uint{str, 0}]; ok {
+				b.WriteString(" " + t.label(n))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString(" ")
+	for fret := t.fb.EndFret; fret >= t.fb.StartFret; fret-- {
+		b.WriteString(center(strconv.Itoa(int(fret)), textFretWidth, " ") + " ")
+	}
+	b.WriteString("\n")
+
+	_, err = io.WriteString(w, strings.TrimRight(b.String(), " \n")+"\n")
+	return err
+}
+
+// fret draws the part of a string between two fret wires, with the note centered on it.
+func (t TextRenderer) fret(str uint, fret uint, cells map[[2]uint]notePosition) string {
+	line := "─"
+	if t.fb.CapoFret(str) == fret {
+		line = "█"
+	}
+
+	n, ok := cells[[2]uint{str, fret}]
+	if !ok {
+		return strings.Repeat(line, textFretWidth)
+	}
+	return center(t.label(n), textFretWidth, line)
+}
+
+// wire returns the character of the fret wire behind the given fret, the nut being fret 0.
+func (t TextRenderer) wire(str uint, fret uint) string {
+	switch {
+	case fret == 0 && t.fb.ShowsNut():
+		return "║"
+	case str == 1:
+		return "┬"
+	case str == t.fb.Strings:
+		return "┴"
+	default:
+		return "┼"
+	}
+}
+
+func (t TextRenderer) label(n notePosition) string {
+	if !t.layout.options.ANSIColors {
+		return n.Label
+	}
+	return ansiColor(roleColors[n.Role]) + " " + n.Label + " " + ansiReset
+}
+
+// center pads the text on both sides to the given width, ANSI escape sequences don't count
+// towards the width.
+func center(text string, width int, padding string) string {
+	length := utf8.RuneCountInString(ansiEscapes.ReplaceAllString(text, ""))
+	if length >= width {
+		return text
+	}
+
+	left := (width - length) / 2
+	return strings.Repeat(padding, left) + text + strings.Repeat(padding, width-length-left)
+}
+
+// ansiColor returns the escape sequence for white bold text on the given 24-bit background
+// colour, like the labels on the circles of the pictures.
+func ansiColor(c color.RGBA) string {
+	return fmt.Sprintf("\x1b[1;38;2;255;255;255;48;2;%d;%d;%dm", c.R, c.G, c.B)
+}
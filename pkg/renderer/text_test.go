@@ -0,0 +1,68 @@
+package renderer
+
+import (
+	"bytes"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestTextRenderer_Render(t *testing.T) {
+	scale, _ := fretboard.NewScale("C", fretboard.ScaleMajorPentatonic)
+
+	t.Run("draw the neck from the nut with the open strings", func(t *testing.T) {
+		fb, _ := fretboard.New(fretboard.Options{EndFret: 3})
+		fb.HighlightScale(scale)
+
+		var buf bytes.Buffer
+		err := NewTextRenderer(fb, Options{DrawTitle: true}).Render(&buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"C major pentatonic",
+			"",
+			"┬──G──┬─────┬─────║ E",
+			"┼──D──┼─────┼──C──║ B",
+			"┼─────┼──A──┼─────║ G",
+			"┼─────┼──E──┼─────║ D",
+			"┼──C──┼─────┼─────║ A",
+			"┴──G──┴─────┴─────║ E",
+			"   3     2     1",
+			"",
+		}, "\n"), buf.String())
+	})
+
+	t.Run("draw a position diagram with a capo", func(t *testing.T) {
+		fb, _ := fretboard.New(fretboard.Options{StartFret: 5, EndFret: 7, Capo: 5})
+		fb.HighlightScale(scale)
+
+		var buf bytes.Buffer
+		err := NewTextRenderer(fb, Options{}).Render(&buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"┬─────┬─────┬██A██┬",
+			"┼─────┼─────┼██E██┼",
+			"┼──D──┼─────┼██C██┼",
+			"┼──A──┼─────┼██G██┼",
+			"┼──E──┼─────┼██D██┼",
+			"┴─────┴─────┴██A██┴",
+			"   7     6     5",
+			"",
+		}, "\n"), buf.String())
+	})
+
+	t.Run("colour the notes like the pictures", func(t *testing.T) {
+		fb, _ := fretboard.New(fretboard.Options{EndFret: 3})
+		fb.HighlightScale(scale)
+
+		var buf bytes.Buffer
+		err := NewTextRenderer(fb, Options{ANSIColors: true}).Render(&buf)
+
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "┼─\x1b[1;38;2;255;255;255;48;2;0;209;178m C \x1b[0m─║")
+		assert.Contains(t, buf.String(), "┬─\x1b[1;38;2;255;255;255;48;2;8;9;10m G \x1b[0m─┬")
+		assert.Contains(t, buf.String(), "║ \x1b[1;38;2;255;255;255;48;2;164;150;155m B \x1b[0m")
+	})
+}
@@ -0,0 +1,231 @@
+package renderer
+
+import (
+	"fmt"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"golang.org/x/image/colornames"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	worksheetMargin         = 40.0
+	worksheetHeaderHeight   = 36.0
+	worksheetFooterHeight   = 24.0
+	worksheetTitleHeight    = 20.0
+	worksheetLegendHeight   = 18.0
+	worksheetNoteLineHeight = 20.0
+	worksheetDiagramGap     = 18.0
+)
+
+// PageSize is the size of a worksheet page in points.
+type PageSize struct {
+	Name   string
+	Width  float64
+	Height float64
+}
+
+var (
+	PageA4     = PageSize{Name: "A4", Width: 595.28, Height: 841.89}
+	PageLetter = PageSize{Name: "Letter", Width: 612, Height: 792}
+)
+
+// ParsePageSize accepts the names of the page sizes, e.g. "A4" or "letter".
+func ParsePageSize(name string) (PageSize, error) {
+	for _, size := range []PageSize{PageA4, PageLetter} {
+		if strings.EqualFold(strings.TrimSpace(name), size.Name) {
+			return size, nil
+		}
+	}
+	return PageSize{}, fmt.Errorf("page size %s is not supported", name)
+}
+
+// Worksheet is a printable PDF handout with several fretboard diagrams, e.g. a scale followed by
+// its diatonic chords. The diagrams are stacked on as many pages as needed, every page gets the
+// title of the worksheet as header and a page number. Every diagram is shown with its title and
+// a legend of the note colours, followed by NoteLines ruled lines to take notes on.
+type Worksheet struct {
+	Title     string
+	PageSize  PageSize
+	NoteLines int
+	diagrams  []worksheetDiagram
+}
+
+type worksheetDiagram struct {
+	fb      *fretboard.Fretboard
+	options Options
+}
+
+func NewWorksheet(title string, pageSize PageSize) *Worksheet {
+	return &Worksheet{Title: title, PageSize: pageSize}
+}
+
+// NewPDFRenderer returns a worksheet with a single diagram on an A4 page, which draws the
+// fretboard like the other formats.
+func NewPDFRenderer(fretboard *fretboard.Fretboard, options Options) *Worksheet {
+	w := NewWorksheet("", PageA4)
+	w.AddFretboard(fretboard, options)
+	return w
+}
+
+// AddFretboard adds a diagram of the fretboard to the worksheet. The title is taken from the
+// options like in the other formats, but it is always drawn, and the offsets are replaced by
+// the margins of the page.
+func (w *Worksheet) AddFretboard(fb *fretboard.Fretboard, options Options) {
+	options.FretboardOffsetX = 10
+	options.FretboardOffsetY = 30
+	w.diagrams = append(w.diagrams, worksheetDiagram{fb: fb, options: options})
+}
+
+func (w *Worksheet) Render(out io.Writer) error {
+	size := w.PageSize
+	if size.Width == 0 || size.Height == 0 {
+		size = PageA4
+	}
+
+	doc := newPDFDocument(w.Title, size.Width, size.Height)
+	contentWidth := size.Width - 2*worksheetMargin
+	contentTop := worksheetMargin
+	if w.Title != "" {
+		contentTop += worksheetHeaderHeight
+	}
+	contentBottom := size.Height - worksheetMargin - worksheetFooterHeight
+
+	var page *pdfPage
+	y := contentTop
+	for _, d := range w.diagrams {
+		l := newLayout(d.fb, d.options)
+		notes, err := l.notes()
+		if err != nil {
+			return err
+		}
+
+		scale := math.Min(1, contentWidth/l.width)
+		height := worksheetTitleHeight + l.height*scale + worksheetLegendHeight + float64(w.NoteLines)*worksheetNoteLineHeight
+		if page == nil || (y+height > contentBottom && y > contentTop) {
+			page = doc.addPage()
+			y = contentTop
+		}
+
+		w.drawDiagram(page, l, notes, worksheetMargin, y, scale, contentWidth)
+		y += height + worksheetDiagramGap
+	}
+	if page == nil {
+		doc.addPage()
+	}
+
+	for i, p := range doc.pages {
+		w.drawHeaderAndFooter(p, size, i+1, len(doc.pages))
+	}
+
+	return doc.write(out)
+}
+
+func (w *Worksheet) drawHeaderAndFooter(p *pdfPage, size PageSize, number int, total int) {
+	p.setFillColor(colornames.Black)
+	p.setStrokeColor(colornames.Black)
+	if w.Title != "" {
+		p.text(pdfFontBold, 16, worksheetMargin, worksheetMargin+16, 0, w.Title)
+		p.setLineWidth(0.5)
+		p.line(worksheetMargin, worksheetMargin+24, size.Width-worksheetMargin, worksheetMargin+24)
+	}
+
+	footer := fmt.Sprintf("Page %d of %d", number, total)
+	p.text(pdfFontRegular, 9, size.Width/2, size.Height-worksheetMargin, 0.5, footer)
+}
+
+// drawDiagram draws the title, the fretboard scaled to fit the width, the legend and the lines
+// for notes with the top left corner at x and y.
+func (w *Worksheet) drawDiagram(p *pdfPage, l layout, notes []notePosition, x, y, scale, width float64) {
+	p.setFillColor(colornames.Black)
+	p.text(pdfFontBold, 12, x, y+14, 0, title(l.fb, l.options))
+	y += worksheetTitleHeight
+
+	w.drawFretboard(p, l, notes, x, y, scale)
+	y += l.height * scale
+
+	w.drawLegend(p, notes, x, y+worksheetLegendHeight/2)
+	y += worksheetLegendHeight
+
+	p.setStrokeColor(colornames.Lightgray)
+	p.setLineWidth(0.5)
+	for i := 1; i <= w.NoteLines; i++ {
+		lineY := y + float64(i)*worksheetNoteLineHeight
+		p.line(x, lineY, x+width, lineY)
+	}
+}
+
+// drawFretboard draws the same picture as the PNGRenderer, scaled by the given factor.
+func (w *Worksheet) drawFretboard(p *pdfPage, l layout, notes []notePosition, x, y, scale float64) {
+	px := func(v float64) float64 { return x + v*scale }
+	py := func(v float64) float64 { return y + v*scale }
+	fb := l.fb
+
+	p.setStrokeColor(colornames.Black)
+	p.setFillColor(colornames.Black)
+	p.setLineWidth(scale)
+	for str := uint(1); str <= fb.Strings; str++ {
+		p.line(px(l.wireX(fb.EndFret)), py(l.stringY(str)), px(l.wireX(fb.StartFret-1)), py(l.stringY(str)))
+	}
+
+	top, bottom := l.stringY(1), l.stringY(fb.Strings)
+	for fret := fb.StartFret - 1; fret <= fb.EndFret; fret++ {
+		p.line(px(l.wireX(fret)), py(top), px(l.wireX(fret)), py(bottom))
+		if fret >= fb.StartFret {
+			p.textCentered(pdfFontRegular, 12*scale, px(l.noteX(fret)), py(l.fretNumberY()), strconv.Itoa(int(fret)))
+		}
+	}
+
+	if fb.ShowsNut() {
+		p.line(px(l.wireX(0)), py(top), px(l.headstockEndX()), py(top-20))
+		p.line(px(l.wireX(0)), py(bottom), px(l.headstockEndX()), py(bottom+20))
+	} else {
+		startX, startY := l.startFretPosition()
+		p.text(pdfFontRegular, 12*scale, px(startX), py(startY)+0.35*12*scale, 0, fmt.Sprintf("%dfr", fb.StartFret))
+	}
+
+	p.setFillColor(colorCapo)
+	for _, bar := range l.capoBars() {
+		p.fillRoundedRect(px(bar.X), py(bar.Y), bar.Width*scale, bar.Height*scale, 5*scale)
+	}
+
+	for _, n := range notes {
+		p.setFillColor(roleColors[n.Role])
+		p.fillCircle(px(n.X), py(n.Y), noteRadius*scale)
+		p.setFillColor(colornames.White)
+		p.textCentered(pdfFontRegular, 12*scale, px(n.X), py(n.Y), n.Label)
+	}
+}
+
+// drawLegend explains the colours of the notes that are part of the diagram.
+func (w *Worksheet) drawLegend(p *pdfPage, notes []notePosition, x, y float64) {
+	legend := []struct {
+		role  noteRole
+		label string
+	}{
+		{role: noteRoleRoot, label: "Root"},
+		{role: noteRoleBass, label: "Bass"},
+		{role: noteRoleChord, label: "Chord tone"},
+		{role: noteRoleScale, label: "Scale note"},
+		{role: noteRoleMisc, label: "Other note"},
+	}
+
+	shown := make(map[noteRole]bool)
+	for _, n := range notes {
+		shown[n.Role] = true
+	}
+
+	for _, entry := range legend {
+		if !shown[entry.role] {
+			continue
+		}
+
+		p.setFillColor(roleColors[entry.role])
+		p.fillCircle(x+4, y, 4)
+		p.setFillColor(colornames.Black)
+		p.text(pdfFontRegular, 9, x+12, y+0.35*9, 0, entry.label)
+		x += 12 + textWidth(entry.label, 9) + 16
+	}
+}
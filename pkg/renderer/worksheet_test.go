@@ -0,0 +1,91 @@
+package renderer
+
+import (
+	"bytes"
+	"github.com/chrismeh/scalemate/pkg/fretboard"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParsePageSize(t *testing.T) {
+	t.Run("accept the names of the page sizes", func(t *testing.T) {
+		tests := []struct {
+			Name     string
+			Expected PageSize
+		}{
+			{Name: "A4", Expected: PageA4},
+			{Name: "a4", Expected: PageA4},
+			{Name: "Letter", Expected: PageLetter},
+			{Name: " letter ", Expected: PageLetter},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				size, err := ParsePageSize(tt.Name)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, size)
+			})
+		}
+	})
+
+	t.Run("return error for an unknown page size", func(t *testing.T) {
+		_, err := ParsePageSize("A5")
+		assert.Error(t, err)
+	})
+}
+
+func TestWorksheet_Render(t *testing.T) {
+	scale, _ := fretboard.NewScale("A", fretboard.ScaleMinor)
+
+	t.Run("put as many diagrams on a page as fit", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			Diagrams      int
+			NoteLines     int
+			ExpectedPages string
+		}{
+			{Name: "empty worksheet", Diagrams: 0, ExpectedPages: "/Count 1"},
+			{Name: "single diagram", Diagrams: 1, ExpectedPages: "/Count 1"},
+			{Name: "scale and chords", Diagrams: 8, ExpectedPages: "/Count 3"},
+			{Name: "scale and chords with notes", Diagrams: 8, NoteLines: 3, ExpectedPages: "/Count 4"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				w := NewWorksheet("A minor", PageA4)
+				w.NoteLines = tt.NoteLines
+				for i := 0; i < tt.Diagrams; i++ {
+					fb, _ := fretboard.New(fretboard.Options{Frets: 12})
+					fb.HighlightScale(scale)
+					w.AddFretboard(fb, Options{})
+				}
+
+				var buf bytes.Buffer
+				err := w.Render(&buf)
+				assert.NoError(t, err)
+				assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-1.4")))
+				assert.True(t, bytes.HasSuffix(buf.Bytes(), []byte("%%EOF\n")))
+				assert.Contains(t, buf.String(), tt.ExpectedPages)
+			})
+		}
+	})
+}
+
+func TestPDFString(t *testing.T) {
+	tests := []struct {
+		Text     string
+		Expected string
+	}{
+		{Text: "A minor (capo 2)", Expected: `(A minor \(capo 2\))`},
+		{Text: `C\D`, Expected: `(C\\D)`},
+		{Text: "viiø7: Bmin7b5", Expected: "(vii\xf87: Bmin7b5)"},
+		{Text: "F♯ vii°", Expected: "(F# vii\xb0)"},
+		{Text: "CΔ", Expected: "(C?)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Text, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, pdfString(tt.Text))
+		})
+	}
+}